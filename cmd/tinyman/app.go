@@ -0,0 +1,352 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/algorand/go-algorand-sdk/client/kmd"
+	"github.com/algorand/go-algorand-sdk/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/mnemonic"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+)
+
+const usage = `Usage: tinyman [flags] <command> [command flags]
+
+Commands:
+  pool info    show reserves and liquidity of a pool
+  quote swap   quote a swap without submitting it
+  swap         swap one asset for another
+  mint         add liquidity to a pool
+  burn         remove liquidity from a pool
+  redeem       redeem excess amounts left in a pool
+  excess       list excess amounts of the user
+  position     show the user position in a pool
+  opt-in       opt in to the validator app or an asset
+  opt-out      opt out of the validator app
+
+Transactions are signed with the mnemonic in TINYMAN_MNEMONIC or -mnemonic-file,
+or with a KMD wallet given by -kmd-wallet and TINYMAN_KMD_PASSWORD.
+Use -export to write the transaction group to a file instead of submitting it.
+Amounts are in asset units, e.g. 1.5 for 1.5 ALGO.
+
+Flags:
+`
+
+// app is the command line application
+type app struct {
+	stdout io.Writer
+	stderr io.Writer
+	getenv func(string) string
+
+	// ac is the algod client, it is created from the flags when it is not set
+	ac types.AlgodAPI
+
+	tc      *tinyman.Client
+	signer  utils.Signer
+	address string
+	output  string
+	export  string
+}
+
+// run parses global flags and runs a command
+func (a *app) run(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("tinyman", flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	fs.Usage = func() {
+		fmt.Fprint(a.stderr, usage)
+		fs.PrintDefaults()
+	}
+
+	network := fs.String("network", "testnet", "network, testnet or mainnet")
+	algodURL := fs.String("algod-url", "", "algod url, defaults to a public node of the network")
+	algodToken := fs.String("algod-token", a.getenv("TINYMAN_ALGOD_TOKEN"), "algod api token")
+	validatorAppID := fs.Uint64("validator-app-id", 0, "validator app id, defaults to the current app of the network")
+	address := fs.String("address", "", "user address, defaults to the address of the mnemonic")
+	mnemonicFile := fs.String("mnemonic-file", "", "file holding a mnemonic to sign with")
+	kmdURL := fs.String("kmd-url", "http://localhost:7833", "kmd url")
+	kmdToken := fs.String("kmd-token", a.getenv("TINYMAN_KMD_TOKEN"), "kmd api token")
+	kmdWallet := fs.String("kmd-wallet", "", "kmd wallet name to sign with")
+	fs.StringVar(&a.export, "export", "", "write transaction groups to a file instead of submitting them, JSON when the file ends with .json, msgpack otherwise")
+	fs.StringVar(&a.output, "output", "table", "output format, table or json")
+	dryrun := fs.Bool("dryrun", false, "dry-run transaction groups before submitting them")
+	assetFile := fs.String("asset-file", "", "JSON file of asset details to preload instead of looking them up")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if a.output != "table" && a.output != "json" {
+		return fmt.Errorf("unknown output format '%s'", a.output)
+	}
+
+	if err := a.setupClient(*network, *algodURL, *algodToken, *validatorAppID); err != nil {
+		return err
+	}
+	if len(*assetFile) > 0 {
+		if err := a.tc.Assets.LoadFile(*assetFile); err != nil {
+			return err
+		}
+	}
+	if err := a.setupSigner(*address, *mnemonicFile, *kmdURL, *kmdToken, *kmdWallet); err != nil {
+		return err
+	}
+	a.tc.DryrunBeforeSubmit = *dryrun
+
+	cmdArgs := fs.Args()
+	if len(cmdArgs) == 0 {
+		fs.Usage()
+
+		return fmt.Errorf("command is required")
+	}
+
+	switch cmdArgs[0] {
+	case "pool":
+		if len(cmdArgs) < 2 || cmdArgs[1] != "info" {
+			return fmt.Errorf("usage: tinyman pool info -asset1 ID -asset2 ID")
+		}
+
+		return a.poolInfo(ctx, cmdArgs[2:])
+	case "quote":
+		if len(cmdArgs) < 2 || cmdArgs[1] != "swap" {
+			return fmt.Errorf("usage: tinyman quote swap -asset-in ID -asset-out ID (-amount-in AMOUNT | -amount-out AMOUNT)")
+		}
+
+		return a.quoteSwap(ctx, cmdArgs[2:])
+	case "swap":
+		return a.swap(ctx, cmdArgs[1:])
+	case "mint":
+		return a.mint(ctx, cmdArgs[1:])
+	case "burn":
+		return a.burn(ctx, cmdArgs[1:])
+	case "redeem":
+		return a.redeem(ctx, cmdArgs[1:])
+	case "excess":
+		return a.excess(ctx, cmdArgs[1:])
+	case "position":
+		return a.position(ctx, cmdArgs[1:])
+	case "opt-in":
+		return a.optIn(ctx, cmdArgs[1:])
+	case "opt-out":
+		return a.optOut(ctx, cmdArgs[1:])
+	}
+
+	return fmt.Errorf("unknown command '%s'", cmdArgs[0])
+}
+
+// setupClient creates the Tinyman client of a network
+func (a *app) setupClient(network, algodURL, algodToken string, validatorAppID uint64) error {
+	var defaultURL string
+	var defaultAppID uint64
+	switch network {
+	case "testnet":
+		defaultURL = constants.AlgodTestnetHost
+		defaultAppID = constants.TestnetValidatorAppId
+	case "mainnet":
+		defaultURL = constants.AlgodMainnetHost
+		defaultAppID = constants.MainnetValidatorAppId
+	default:
+		return fmt.Errorf("unknown network '%s'", network)
+	}
+
+	if len(algodURL) == 0 {
+		algodURL = defaultURL
+	}
+	if validatorAppID == 0 {
+		validatorAppID = defaultAppID
+	}
+
+	if a.ac == nil {
+		ac, err := algod.MakeClient(algodURL, algodToken)
+		if err != nil {
+			return err
+		}
+
+		a.ac = utils.NewAlgodAPI(ac)
+	}
+
+	a.tc = tinyman.NewClient(a.ac, validatorAppID, "")
+
+	return nil
+}
+
+// setupSigner sets up the user address and the signer from a mnemonic or a KMD wallet
+func (a *app) setupSigner(address, mnemonicFile, kmdURL, kmdToken, kmdWallet string) error {
+	words := a.getenv("TINYMAN_MNEMONIC")
+	if len(mnemonicFile) > 0 {
+		content, err := os.ReadFile(mnemonicFile)
+		if err != nil {
+			return err
+		}
+
+		words = string(content)
+	}
+
+	if len(words) > 0 {
+		sk, err := mnemonic.ToPrivateKey(strings.TrimSpace(words))
+		if err != nil {
+			return err
+		}
+
+		account, err := crypto.AccountFromPrivateKey(sk)
+		if err != nil {
+			return err
+		}
+		if len(address) > 0 && address != account.Address.String() {
+			return fmt.Errorf("address %s does not match the mnemonic", address)
+		}
+
+		address = account.Address.String()
+		a.signer = utils.NewAccountSigner(account)
+	} else if len(kmdWallet) > 0 {
+		if len(address) == 0 {
+			return fmt.Errorf("-address is required when signing with a kmd wallet")
+		}
+
+		kc, err := kmd.MakeClient(kmdURL, kmdToken)
+		if err != nil {
+			return err
+		}
+
+		walletID, err := kmdWalletID(kc, kmdWallet)
+		if err != nil {
+			return err
+		}
+
+		a.signer = utils.NewKMDSigner(kc, walletID, a.getenv("TINYMAN_KMD_PASSWORD"))
+	}
+
+	a.address = address
+	a.tc.UserAddress = address
+
+	return nil
+}
+
+// kmdWalletID returns the id of a KMD wallet by its name
+func kmdWalletID(kc kmd.Client, name string) (string, error) {
+	res, err := kc.ListWallets()
+	if err != nil {
+		return "", err
+	}
+
+	for _, wallet := range res.Wallets {
+		if wallet.Name == name {
+			return wallet.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("kmd wallet '%s' does not exist", name)
+}
+
+// userAddress returns the user address or an error when it is not set
+func (a *app) userAddress() (string, error) {
+	if len(a.address) == 0 {
+		return "", fmt.Errorf("-address or a mnemonic is required")
+	}
+
+	return a.address, nil
+}
+
+// execution is the outcome of executing a transaction group
+type execution struct {
+	TxID     string   `json:",omitempty"`
+	TxIDs    []string `json:",omitempty"`
+	Exported string   `json:",omitempty"`
+}
+
+// executePlan signs and submits a plan in order, groups of its steps are prepared from the chain state and signed on the way.
+// A plan of a single group without steps can be exported as well.
+func (a *app) executePlan(ctx context.Context, plan *utils.TransactionPlan) (*execution, error) {
+	txGroups := plan.Groups()
+	if len(a.export) > 0 {
+		if plan.HasSteps() {
+			return nil, fmt.Errorf("-export does not support operations whose groups are prepared after submitting the previous ones")
+		}
+		if len(txGroups) != 1 {
+			return nil, fmt.Errorf("-export only supports a single transaction group, the operation needs %d", len(txGroups))
+		}
+
+		return a.execute(ctx, txGroups[0])
+	}
+
+	if a.signer == nil {
+		return nil, fmt.Errorf("no signer, set TINYMAN_MNEMONIC, -mnemonic-file, -kmd-wallet or -export")
+	}
+	if err := plan.SignWith(ctx, a.signer); err != nil {
+		return nil, err
+	}
+
+	txIDs, err := plan.SubmitWith(ctx, a.tc.Submit, true)
+	if err != nil {
+		return nil, err
+	}
+
+	exec := &execution{}
+	if len(txIDs) > 0 {
+		exec.TxID = txIDs[len(txIDs)-1]
+	}
+	if len(txIDs) > 1 {
+		exec.TxIDs = txIDs
+	}
+
+	return exec, nil
+}
+
+// execute signs and submits a transaction group, or exports it when -export is set
+func (a *app) execute(ctx context.Context, txGroup *utils.TransactionGroup) (*execution, error) {
+	if len(a.export) > 0 {
+		encoded := txGroup.EncodeMsgpack()
+		if filepath.Ext(a.export) == ".json" {
+			var err error
+			encoded, err = txGroup.EncodeJSON()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err := os.WriteFile(a.export, encoded, 0600); err != nil {
+			return nil, err
+		}
+
+		return &execution{Exported: a.export}, nil
+	}
+
+	if a.signer == nil {
+		return nil, fmt.Errorf("no signer, set TINYMAN_MNEMONIC, -mnemonic-file, -kmd-wallet or -export")
+	}
+	if err := txGroup.SignWith(ctx, a.signer); err != nil {
+		return nil, err
+	}
+
+	txID, err := a.tc.Submit(ctx, txGroup, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &execution{TxID: txID}, nil
+}
+
+// rows returns table rows of an execution
+func (e *execution) rows() [][]string {
+	if len(e.Exported) > 0 {
+		return [][]string{{"Exported", e.Exported}}
+	}
+	if len(e.TxIDs) > 1 {
+		var rows [][]string
+		for _, txID := range e.TxIDs {
+			rows = append(rows, []string{"Transaction", txID})
+		}
+
+		return rows
+	}
+
+	return [][]string{{"Transaction", e.TxID}}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+)
+
+// burnResult is the outcome of a burn
+type burnResult struct {
+	Quote     *types.BurnQuote
+	Execution *execution
+}
+
+// burn removes liquidity from a pool
+func (a *app) burn(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("burn", flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	asset1ID := fs.Uint64("asset1", 0, "id of one pool asset, 0 for ALGO")
+	asset2ID := fs.Uint64("asset2", 0, "id of the other pool asset, 0 for ALGO")
+	liquidity := fs.String("liquidity", "", "amount of the liquidity asset to burn")
+	slippageBps := fs.Uint64("slippage-bps", 100, "slippage tolerance in basis points, 100 is 1%")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	userAddress, err := a.userAddress()
+	if err != nil {
+		return err
+	}
+
+	pool, err := a.fetchPool(ctx, *asset1ID, *asset2ID)
+	if err != nil {
+		return err
+	}
+
+	liquidityAmount, err := parseAmount(pool.LiquidityAsset, *liquidity)
+	if err != nil {
+		return err
+	}
+
+	quote, err := pool.FetchBurnQuote(ctx, liquidityAmount, *slippageBps)
+	if err != nil {
+		return err
+	}
+
+	minAmountsOut, err := quote.AmountsOutWithSlippage()
+	if err != nil {
+		return err
+	}
+
+	txGroup, err := pool.PrepareBurnTransactionsFromQuote(ctx, quote, userAddress)
+	if err != nil {
+		return err
+	}
+
+	exec, err := a.execute(ctx, txGroup)
+	if err != nil {
+		return err
+	}
+
+	rows := [][]string{{"Liquidity", formatAmount(&quote.LiquidityAssetAmount)}}
+	for _, assetID := range []uint64{pool.Asset1.ID, pool.Asset2.ID} {
+		amount := quote.AmountsOut[assetID]
+		minAmount := minAmountsOut[assetID]
+		rows = append(rows,
+			[]string{"Amount out", formatAmount(&amount)},
+			[]string{"Minimum amount out", formatAmount(&minAmount)},
+		)
+	}
+
+	return a.print(&burnResult{Quote: quote, Execution: exec}, append(rows, exec.rows()...))
+}
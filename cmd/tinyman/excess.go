@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"flag"
+)
+
+// excess lists excess amounts of the user
+func (a *app) excess(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("excess", flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	userAddress, err := a.userAddress()
+	if err != nil {
+		return err
+	}
+
+	quotes, err := a.tc.FetchExcessAmount(ctx, userAddress)
+	if err != nil {
+		return err
+	}
+
+	rows := [][]string{{"POOL", "AMOUNT"}}
+	for idx := range quotes {
+		rows = append(rows, []string{quotes[idx].PoolAddress, formatAmount(&quotes[idx].Amount)})
+	}
+
+	return a.print(quotes, rows)
+}
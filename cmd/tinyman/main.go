@@ -0,0 +1,20 @@
+// Command tinyman interacts with Tinyman pools from the command line
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+func main() {
+	a := &app{
+		stdout: os.Stdout,
+		stderr: os.Stderr,
+		getenv: os.Getenv,
+	}
+	if err := a.run(context.Background(), os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "tinyman: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
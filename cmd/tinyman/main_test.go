@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/mnemonic"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/tinymantest"
+)
+
+// run runs a command as the fixture user against the fixture simulator
+func run(e *tinymantest.Fixture, args ...string) (string, error) {
+	words, err := mnemonic.FromPrivateKey(e.User.PrivateKey)
+	if err != nil {
+		return "", err
+	}
+
+	var stdout, stderr bytes.Buffer
+	a := &app{
+		stdout: &stdout,
+		stderr: &stderr,
+		getenv: func(key string) string {
+			if key == "TINYMAN_MNEMONIC" {
+				return words
+			}
+
+			return ""
+		},
+		ac: e.Sim,
+	}
+	err = a.run(e.Ctx, args)
+
+	return stdout.String(), err
+}
+
+func mustRun(e *tinymantest.Fixture, args ...string) string {
+	out, err := run(e, args...)
+	if err != nil {
+		e.T.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	return out
+}
+
+func TestCommands(t *testing.T) {
+	e := tinymantest.New(t)
+	mustRun(e, "opt-in")
+
+	token := e.CreateAsset("TKN")
+	pool, err := e.Client.FetchPool(e.Ctx, token, e.Asset(0), false)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	e.Submit(pool.PrepareBootstrapTransactions(e.Ctx, ""))
+	e.Refresh(pool)
+	e.Submit(pool.PrepareLiquidityAssetOptInTransactions(e.Ctx, ""))
+
+	assetID := token.ID
+	asset1 := "0"
+	asset2 := strconv.FormatUint(assetID, 10)
+	mustRun(e, "mint", "-asset1", asset2, "-asset2", asset1, "-amount1", "2000", "-amount2", "1000")
+
+	var info types.PoolInfo
+	if err := json.Unmarshal([]byte(mustRun(e, "-output", "json", "pool", "info", "-asset1", asset1, "-asset2", asset2)), &info); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if info.Asset1Reserves != 2000000000 || info.Asset2Reserves != 1000000000 {
+		t.Fatalf("pool info returned wrong reserves %d %d", info.Asset1Reserves, info.Asset2Reserves)
+	}
+
+	quote := mustRun(e, "quote", "swap", "-asset-in", asset1, "-asset-out", asset2, "-amount-in", "1.5")
+	if !strings.Contains(quote, "1.500000 ALGO") || !strings.Contains(quote, "Minimum amount out") {
+		t.Errorf("quote swap returned wrong output\n%s", quote)
+	}
+
+	before := e.Sim.Balance(e.Address(), assetID)
+	mustRun(e, "swap", "-asset-in", asset1, "-asset-out", asset2, "-amount-in", "1.5")
+	if e.Sim.Balance(e.Address(), assetID) <= before {
+		t.Errorf("swap did not transfer the output asset")
+	}
+
+	export := filepath.Join(t.TempDir(), "swap.json")
+	mustRun(e, "-export", export, "swap", "-asset-in", asset2, "-asset-out", asset1, "-amount-out", "1")
+	encoded, err := os.ReadFile(export)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	txGroup, err := utils.DecodeTransactionGroupJSON(encoded)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(txGroup.UnsignedIndexes()) == 0 {
+		t.Errorf("swap should export a group which is not signed by the user")
+	}
+
+	position := mustRun(e, "position", "-asset1", asset1, "-asset2", asset2)
+	if !strings.Contains(position, "Share") {
+		t.Errorf("position returned wrong output\n%s", position)
+	}
+
+	if _, err := run(e, "unknown"); err == nil {
+		t.Errorf("run should return an error of an unknown command")
+	}
+}
+
+func TestExecutePlanWithSteps(t *testing.T) {
+	e := tinymantest.New(t)
+	token := e.CreateAsset("TKN")
+	other := e.NewUser()
+
+	optIn, err := other.Client.PrepareAppOptInTransaction(other.Ctx, "")
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	plan := utils.NewTransactionPlan(optIn)
+	plan.AddStep(func(ctx context.Context) ([]*utils.TransactionGroup, error) {
+		assetOptIn, err := other.Client.PrepareAssetOptInTransactions(ctx, token.ID, "")
+		if err != nil {
+			return nil, err
+		}
+
+		return []*utils.TransactionGroup{assetOptIn}, nil
+	})
+
+	var stdout, stderr bytes.Buffer
+	a := &app{stdout: &stdout, stderr: &stderr, ac: e.Sim, tc: other.Client, export: filepath.Join(t.TempDir(), "plan.json")}
+	if _, err := a.executePlan(other.Ctx, plan); err == nil {
+		t.Fatalf("executePlan should refuse to export a plan with steps")
+	}
+
+	a.export = ""
+	a.signer = utils.NewAccountSigner(other.User)
+	exec, err := a.executePlan(other.Ctx, plan)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(exec.TxIDs) != 2 {
+		t.Errorf("executePlan should submit the group and the group of the step, got %d", len(exec.TxIDs))
+	}
+	if optedIn, err := other.Client.IsAssetOptedIn(other.Ctx, token.ID, ""); err != nil || !optedIn {
+		t.Errorf("executePlan should submit groups prepared by steps")
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	asset := &types.Asset{ID: 1, Decimals: 6, UnitName: "TKN"}
+	cases := map[string]uint64{
+		"1":        1000000,
+		"1.5":      1500000,
+		"0.000001": 1,
+		".25":      250000,
+	}
+	for amount, expected := range cases {
+		parsed, err := parseAmount(asset, amount)
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+		if parsed.Amount != expected {
+			t.Errorf("parseAmount returned %d instead of %d for %s", parsed.Amount, expected, amount)
+		}
+		if formatted, _ := parseAmount(asset, strings.TrimSuffix(formatAmount(parsed), " TKN")); formatted.Amount != expected {
+			t.Errorf("formatAmount returned wrong amount %s", formatAmount(parsed))
+		}
+	}
+
+	if _, err := parseAmount(asset, "0.0000001"); err == nil {
+		t.Errorf("parseAmount should reject too many decimals")
+	}
+}
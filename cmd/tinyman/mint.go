@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+)
+
+// mintResult is the outcome of a mint
+type mintResult struct {
+	Quote     *types.MintQuote
+	Execution *execution
+}
+
+// mint adds liquidity to a pool
+func (a *app) mint(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("mint", flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	asset1ID := fs.Uint64("asset1", 0, "id of one pool asset, 0 for ALGO")
+	asset2ID := fs.Uint64("asset2", 0, "id of the other pool asset, 0 for ALGO")
+	amount1 := fs.String("amount1", "", "amount of -asset1")
+	amount2 := fs.String("amount2", "", "amount of -asset2, it is calculated from the pool price when omitted")
+	slippageBps := fs.Uint64("slippage-bps", 100, "slippage tolerance in basis points, 100 is 1%")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	userAddress, err := a.userAddress()
+	if err != nil {
+		return err
+	}
+
+	pool, err := a.fetchPool(ctx, *asset1ID, *asset2ID)
+	if err != nil {
+		return err
+	}
+
+	asset1, err := a.tc.FetchAsset(ctx, *asset1ID)
+	if err != nil {
+		return err
+	}
+
+	amountA, err := parseAmount(asset1, *amount1)
+	if err != nil {
+		return err
+	}
+
+	var amountB *types.AssetAmount
+	if len(*amount2) > 0 {
+		asset2, err := a.tc.FetchAsset(ctx, *asset2ID)
+		if err != nil {
+			return err
+		}
+
+		amountB, err = parseAmount(asset2, *amount2)
+		if err != nil {
+			return err
+		}
+	}
+
+	quote, err := pool.FetchMintQuote(ctx, amountA, amountB, *slippageBps)
+	if err != nil {
+		return err
+	}
+
+	minLiquidity, err := quote.LiquidityAssetAmountWithSlippage()
+	if err != nil {
+		return err
+	}
+
+	txGroup, err := pool.PrepareMintTransactionsFromQuote(ctx, quote, userAddress)
+	if err != nil {
+		return err
+	}
+
+	exec, err := a.execute(ctx, txGroup)
+	if err != nil {
+		return err
+	}
+
+	var rows [][]string
+	for _, assetID := range []uint64{pool.Asset1.ID, pool.Asset2.ID} {
+		amount := quote.AmountsIn[assetID]
+		rows = append(rows, []string{"Amount in", formatAmount(&amount)})
+	}
+	rows = append(rows,
+		[]string{"Liquidity", formatAmount(&quote.LiquidityAssetAmount)},
+		[]string{"Minimum liquidity", formatAmount(minLiquidity)},
+	)
+
+	return a.print(&mintResult{Quote: quote, Execution: exec}, append(rows, exec.rows()...))
+}
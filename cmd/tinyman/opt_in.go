@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/synycboom/tinyman-go-sdk/utils"
+)
+
+// optIn opts in to the validator app or an asset
+func (a *app) optIn(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("opt-in", flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	assetID := fs.Uint64("asset", 0, "id of an asset to opt in to, the validator app is opted in to when omitted")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	userAddress, err := a.userAddress()
+	if err != nil {
+		return err
+	}
+
+	var txGroup *utils.TransactionGroup
+	if *assetID > 0 {
+		txGroup, err = a.tc.PrepareAssetOptInTransactions(ctx, *assetID, userAddress)
+	} else {
+		txGroup, err = a.tc.PrepareAppOptInTransaction(ctx, userAddress)
+	}
+	if err != nil {
+		return err
+	}
+
+	exec, err := a.execute(ctx, txGroup)
+	if err != nil {
+		return err
+	}
+
+	return a.print(exec, exec.rows())
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/synycboom/tinyman-go-sdk/v1"
+)
+
+// optOut opts out of the validator app
+func (a *app) optOut(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("opt-out", flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	redeemExcess := fs.Bool("redeem-excess", false, "redeem outstanding excess amounts first instead of refusing to opt out")
+	closeOut := fs.Bool("close-out", false, "close empty holdings of liquidity assets and of the assets of their pools")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	userAddress, err := a.userAddress()
+	if err != nil {
+		return err
+	}
+
+	plan, err := a.tc.PrepareAppOptOutTransaction(ctx, userAddress, &tinyman.OptOutOptions{
+		RedeemExcess: *redeemExcess,
+		CloseOut:     *closeOut,
+	})
+	if err != nil {
+		return err
+	}
+
+	exec, err := a.executePlan(ctx, plan)
+	if err != nil {
+		return err
+	}
+
+	return a.print(exec, exec.rows())
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/v1/pools"
+)
+
+// print writes a value as indented JSON, or its rows as a table
+func (a *app) print(v interface{}, rows [][]string) error {
+	if a.output == "json" {
+		encoded, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(a.stdout, string(encoded))
+
+		return err
+	}
+
+	tw := tabwriter.NewWriter(a.stdout, 0, 0, 2, ' ', 0)
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+// fetchPool fetches a pool of two assets
+func (a *app) fetchPool(ctx context.Context, asset1ID, asset2ID uint64) (*pools.Pool, error) {
+	if asset1ID == asset2ID {
+		return nil, fmt.Errorf("-asset1 and -asset2 must be different")
+	}
+
+	asset1, err := a.tc.FetchAsset(ctx, asset1ID)
+	if err != nil {
+		return nil, err
+	}
+
+	asset2, err := a.tc.FetchAsset(ctx, asset2ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.tc.FetchPool(ctx, asset1, asset2, true)
+}
+
+// parseAmount parses an amount in asset units, e.g. 1.5, into base units
+func parseAmount(asset *types.Asset, amount string) (*types.AssetAmount, error) {
+	whole, fraction, _ := strings.Cut(amount, ".")
+	if uint64(len(fraction)) > asset.Decimals {
+		return nil, fmt.Errorf("amount %s has more than %d decimals", amount, asset.Decimals)
+	}
+
+	baseUnits := whole + fraction + strings.Repeat("0", int(asset.Decimals)-len(fraction))
+	value, err := strconv.ParseUint(baseUnits, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount '%s'", amount)
+	}
+
+	return &types.AssetAmount{Asset: asset, Amount: value}, nil
+}
+
+// formatAmount formats an amount in asset units followed by the asset unit name
+func formatAmount(amount *types.AssetAmount) string {
+	if amount == nil {
+		return ""
+	}
+
+	formatted := strconv.FormatUint(amount.Amount, 10)
+	decimals := int(amount.Asset.Decimals)
+	if decimals > 0 {
+		if len(formatted) <= decimals {
+			formatted = strings.Repeat("0", decimals-len(formatted)+1) + formatted
+		}
+
+		formatted = formatted[:len(formatted)-decimals] + "." + formatted[len(formatted)-decimals:]
+	}
+
+	return fmt.Sprintf("%s %s", formatted, amount.Asset.UnitName)
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+)
+
+// poolInfo shows reserves and liquidity of a pool
+func (a *app) poolInfo(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("pool info", flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	asset1ID := fs.Uint64("asset1", 0, "id of one pool asset, 0 for ALGO")
+	asset2ID := fs.Uint64("asset2", 0, "id of the other pool asset, 0 for ALGO")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	pool, err := a.fetchPool(ctx, *asset1ID, *asset2ID)
+	if err != nil {
+		return err
+	}
+
+	info, err := pool.Info()
+	if err != nil {
+		return err
+	}
+
+	rows := [][]string{
+		{"Address", info.Address},
+		{"Validator app", strconv.FormatUint(info.ValidatorAppID, 10)},
+		{"Bootstrapped", strconv.FormatBool(info.LiquidityAssetID > 0)},
+		{"Round", strconv.FormatUint(info.Round, 10)},
+	}
+	if info.LiquidityAssetID > 0 {
+		rows = append(rows,
+			[]string{"Asset 1", pool.Asset1.String()},
+			[]string{"Asset 2", pool.Asset2.String()},
+			[]string{"Liquidity asset", pool.LiquidityAsset.String()},
+			[]string{"Asset 1 reserves", formatAmount(&types.AssetAmount{Asset: pool.Asset1, Amount: info.Asset1Reserves})},
+			[]string{"Asset 2 reserves", formatAmount(&types.AssetAmount{Asset: pool.Asset2, Amount: info.Asset2Reserves})},
+			[]string{"Issued liquidity", formatAmount(&types.AssetAmount{Asset: pool.LiquidityAsset, Amount: info.IssuedLiquidity})},
+		)
+	}
+	if info.Asset1Reserves > 0 && info.Asset2Reserves > 0 {
+		// prices are ratios of base units, they are scaled to ratios of asset units for display
+		decimals := int(pool.Asset1.Decimals) - int(pool.Asset2.Decimals)
+		rows = append(rows,
+			[]string{"Asset 1 price", fmt.Sprintf("%g %s", pool.Asset1Price()*math.Pow10(decimals), pool.Asset2.UnitName)},
+			[]string{"Asset 2 price", fmt.Sprintf("%g %s", pool.Asset2Price()*math.Pow10(-decimals), pool.Asset1.UnitName)},
+		)
+	}
+
+	return a.print(info, rows)
+}
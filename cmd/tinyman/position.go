@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+)
+
+// position shows the user position in a pool
+func (a *app) position(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("position", flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	asset1ID := fs.Uint64("asset1", 0, "id of one pool asset, 0 for ALGO")
+	asset2ID := fs.Uint64("asset2", 0, "id of the other pool asset, 0 for ALGO")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	userAddress, err := a.userAddress()
+	if err != nil {
+		return err
+	}
+
+	pool, err := a.fetchPool(ctx, *asset1ID, *asset2ID)
+	if err != nil {
+		return err
+	}
+
+	position, err := pool.FetchPoolPosition(ctx, userAddress)
+	if err != nil {
+		return err
+	}
+
+	return a.print(position, [][]string{
+		{"Liquidity", formatAmount(&position.LiquidityAsset)},
+		{"Asset 1", formatAmount(&position.Asset1)},
+		{"Asset 2", formatAmount(&position.Asset2)},
+		{"Share", fmt.Sprintf("%.4f%%", position.Share*100)},
+	})
+}
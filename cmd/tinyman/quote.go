@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/v1/pools"
+)
+
+// swapFlags are flags of a swap and a swap quote
+type swapFlags struct {
+	assetInID   *uint64
+	assetOutID  *uint64
+	amountIn    *string
+	amountOut   *string
+	slippageBps *uint64
+}
+
+// newSwapFlags registers swap flags to a flag set
+func newSwapFlags(fs *flag.FlagSet) *swapFlags {
+	return &swapFlags{
+		assetInID:   fs.Uint64("asset-in", 0, "id of the input asset, 0 for ALGO"),
+		assetOutID:  fs.Uint64("asset-out", 0, "id of the output asset, 0 for ALGO"),
+		amountIn:    fs.String("amount-in", "", "fixed input amount"),
+		amountOut:   fs.String("amount-out", "", "fixed output amount"),
+		slippageBps: fs.Uint64("slippage-bps", 100, "slippage tolerance in basis points, 100 is 1%"),
+	}
+}
+
+// fetchSwapQuote fetches a fixed input or a fixed output swap quote from swap flags
+func (a *app) fetchSwapQuote(ctx context.Context, f *swapFlags) (*pools.Pool, *types.SwapQuote, error) {
+	if (len(*f.amountIn) == 0) == (len(*f.amountOut) == 0) {
+		return nil, nil, fmt.Errorf("either -amount-in or -amount-out is required")
+	}
+
+	pool, err := a.fetchPool(ctx, *f.assetInID, *f.assetOutID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(*f.amountIn) > 0 {
+		assetIn, err := a.tc.FetchAsset(ctx, *f.assetInID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		amountIn, err := parseAmount(assetIn, *f.amountIn)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		quote, err := pool.FixedInputSwapQuote(amountIn, *f.slippageBps)
+
+		return pool, quote, err
+	}
+
+	assetOut, err := a.tc.FetchAsset(ctx, *f.assetOutID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	amountOut, err := parseAmount(assetOut, *f.amountOut)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	quote, err := pool.FixedOutputSwapQuote(amountOut, *f.slippageBps)
+
+	return pool, quote, err
+}
+
+// quoteRows returns table rows of a swap quote
+func quoteRows(quote *types.SwapQuote) ([][]string, error) {
+	minAmountOut, err := quote.AmountOutWithSlippage()
+	if err != nil {
+		return nil, err
+	}
+
+	maxAmountIn, err := quote.AmountInWithSlippage()
+	if err != nil {
+		return nil, err
+	}
+
+	// Price is a ratio of base units, it is scaled to a ratio of asset units for display
+	in, out := quote.AmountIn.Asset, quote.AmountOut.Asset
+	price := quote.Price() * math.Pow10(int(in.Decimals)-int(out.Decimals))
+
+	return [][]string{
+		{"Swap type", quote.SwapType},
+		{"Amount in", formatAmount(quote.AmountIn)},
+		{"Amount out", formatAmount(quote.AmountOut)},
+		{"Maximum amount in", formatAmount(maxAmountIn)},
+		{"Minimum amount out", formatAmount(minAmountOut)},
+		{"Swap fee", formatAmount(quote.SwapFee)},
+		{"Price", fmt.Sprintf("%g %s per %s", price, out.UnitName, in.UnitName)},
+		{"Price impact", fmt.Sprintf("%.2f%%", quote.PriceImpactBps/100)},
+	}, nil
+}
+
+// quoteSwap quotes a swap without submitting it
+func (a *app) quoteSwap(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("quote swap", flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	f := newSwapFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	_, quote, err := a.fetchSwapQuote(ctx, f)
+	if err != nil {
+		return err
+	}
+
+	rows, err := quoteRows(quote)
+	if err != nil {
+		return err
+	}
+
+	return a.print(quote, rows)
+}
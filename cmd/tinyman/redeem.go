@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+)
+
+// redeemResult is the outcome of a redeem
+type redeemResult struct {
+	Quote     types.RedeemQuote
+	Execution *execution
+}
+
+// redeem redeems excess amounts left in a pool
+func (a *app) redeem(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("redeem", flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	asset1ID := fs.Uint64("asset1", 0, "id of one pool asset, 0 for ALGO")
+	asset2ID := fs.Uint64("asset2", 0, "id of the other pool asset, 0 for ALGO")
+	assetID := fs.Int64("asset", -1, "id of the asset to redeem, all excess amounts of the pool are redeemed when omitted")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	userAddress, err := a.userAddress()
+	if err != nil {
+		return err
+	}
+
+	pool, err := a.fetchPool(ctx, *asset1ID, *asset2ID)
+	if err != nil {
+		return err
+	}
+
+	excess, err := a.tc.FetchExcessAmount(ctx, userAddress)
+	if err != nil {
+		return err
+	}
+
+	quotes, err := pool.FilterRedeemQuotes(excess)
+	if err != nil {
+		return err
+	}
+	if *assetID >= 0 {
+		quote, err := pool.GetRedeemQuoteMatchesAssetID(uint64(*assetID), quotes)
+		if err != nil {
+			return err
+		}
+
+		quotes = nil
+		if quote != nil {
+			quotes = []types.RedeemQuote{*quote}
+		}
+	}
+	if len(quotes) == 0 {
+		return fmt.Errorf("there is no excess amount to redeem")
+	}
+	if len(quotes) > 1 && len(a.export) > 0 {
+		return fmt.Errorf("-export writes a single transaction group, use -asset to redeem one asset")
+	}
+
+	var results []redeemResult
+	var rows [][]string
+	for _, quote := range quotes {
+		txGroup, err := pool.PrepareRedeemTransactionsFromQuote(ctx, &quote, userAddress)
+		if err != nil {
+			return err
+		}
+
+		exec, err := a.execute(ctx, txGroup)
+		if err != nil {
+			return err
+		}
+
+		results = append(results, redeemResult{Quote: quote, Execution: exec})
+		rows = append(rows, []string{"Redeemed", formatAmount(&quote.Amount)})
+		rows = append(rows, exec.rows()...)
+	}
+
+	return a.print(results, rows)
+}
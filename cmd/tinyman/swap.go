@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+)
+
+// swapResult is the outcome of a swap
+type swapResult struct {
+	Quote     *types.SwapQuote
+	Execution *execution
+}
+
+// swap swaps one asset for another
+func (a *app) swap(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("swap", flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	f := newSwapFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	userAddress, err := a.userAddress()
+	if err != nil {
+		return err
+	}
+
+	pool, quote, err := a.fetchSwapQuote(ctx, f)
+	if err != nil {
+		return err
+	}
+
+	rows, err := quoteRows(quote)
+	if err != nil {
+		return err
+	}
+
+	txGroup, err := pool.PrepareSwapTransactionsFromQuote(ctx, quote, userAddress)
+	if err != nil {
+		return err
+	}
+
+	exec, err := a.execute(ctx, txGroup)
+	if err != nil {
+		return err
+	}
+
+	return a.print(&swapResult{Quote: quote, Execution: exec}, append(rows, exec.rows()...))
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	exampleUtils "github.com/synycboom/tinyman-go-sdk/example/utils"
-	"github.com/synycboom/tinyman-go-sdk/v1/pools"
 )
 
 // This sample is provided for demonstration purposes only.
@@ -45,7 +44,7 @@ func main() {
 	}
 
 	// Fetch the created pool
-	pool, err := pools.NewPool(ctx, ac, token, algo, nil, tc.ValidatorAppID, userAddress, true)
+	pool, err := tc.FetchPool(ctx, token, algo, true)
 	if err != nil {
 		panic(err)
 	}
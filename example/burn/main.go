@@ -70,7 +70,7 @@ func main() {
 	fmt.Printf("Current balance of liquidity \n\t - ID:%v = %v\n", pool.LiquidityAsset.ID, balance.Amount)
 
 	// Fetch burn quote used when buring liquidity asset
-	quote, err := pool.FetchBurnQuote(ctx, balance, 0.05)
+	quote, err := pool.FetchBurnQuote(ctx, balance, 500)
 	if err != nil {
 		panic(err)
 	}
@@ -57,7 +57,7 @@ func main() {
 	// Fetch mint quote used when submit minting transactions
 	// Note that 500000 is equal to 500000 / 10 ** decimals (=6), which is 0.5USDC
 	usdcAssetAmount, _ := types.NewAssetAmount(usdc, 500000)
-	quote, err := pool.FetchMintQuote(ctx, usdcAssetAmount, nil, 0.05)
+	quote, err := pool.FetchMintQuote(ctx, usdcAssetAmount, nil, 500)
 	if err != nil {
 		panic(err)
 	}
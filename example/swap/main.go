@@ -57,7 +57,7 @@ func main() {
 	}
 
 	// Fetch swap quote used when submit swapping transactions
-	quote, err := pool.FetchFixedInputSwapQuote(ctx, algoAmount, 0.05)
+	quote, err := pool.FetchFixedInputSwapQuote(ctx, algoAmount, 500)
 	if err != nil {
 		panic(err)
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/algorand/go-algorand-sdk/client/v2/algod"
 	"github.com/algorand/go-algorand-sdk/crypto"
 	"github.com/algorand/go-algorand-sdk/mnemonic"
+	tUtils "github.com/synycboom/tinyman-go-sdk/utils"
 	"github.com/synycboom/tinyman-go-sdk/v1"
 	"github.com/synycboom/tinyman-go-sdk/v1/constants"
 	"golang.org/x/crypto/ed25519"
@@ -80,7 +81,7 @@ func Clients(userAddress string) (*algod.Client, *tinyman.Client, error) {
 		return nil, nil, err
 	}
 
-	client, err := tinyman.NewTestNetClient(algodCli, userAddress)
+	client, err := tinyman.NewTestNetClient(tUtils.NewAlgodAPI(algodCli), userAddress)
 	if err != nil {
 		return nil, nil, err
 	}
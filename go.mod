@@ -4,6 +4,7 @@ go 1.18
 
 require (
 	github.com/algorand/go-algorand-sdk v1.14.1
+	go.etcd.io/bbolt v1.3.7
 	golang.org/x/crypto v0.0.0-20220331220935-ae2d96664a29
 )
 
@@ -11,5 +12,5 @@ require (
 	github.com/algorand/go-algorand v0.0.0-20220402183304-0146fff73224 // indirect
 	github.com/algorand/go-codec/codec v1.1.8 // indirect
 	github.com/google/go-querystring v1.1.0 // indirect
-	golang.org/x/sys v0.0.0-20220403205710-6acee93ad0eb // indirect
+	golang.org/x/sys v0.4.0 // indirect
 )
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/algorand/go-algorand-sdk/client/v2/algod"
 	"github.com/synycboom/tinyman-go-sdk/v1/constants"
 )
 
@@ -41,9 +40,9 @@ func (a *Asset) String() string {
 }
 
 // Fetch fetches and updates the asset information
-func (a *Asset) Fetch(ctx context.Context, ac *algod.Client) error {
+func (a *Asset) Fetch(ctx context.Context, ac AlgodAPI) error {
 	if a.ID > 0 {
-		asset, err := ac.GetAssetByID(a.ID).Do(ctx)
+		asset, err := ac.GetAssetByID(ctx, a.ID)
 		if err != nil {
 			return err
 		}
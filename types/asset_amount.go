@@ -3,6 +3,9 @@ package types
 import (
 	"fmt"
 	"math"
+	"math/big"
+
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
 )
 
 // AssetAmount represents an asset amount
@@ -36,7 +39,7 @@ func (a *AssetAmount) Mul(other *AssetAmount, numOther *float64) (*AssetAmount,
 	}
 
 	if !a.Asset.Equal(other.Asset) {
-		return nil, fmt.Errorf("the other asset is mismatched")
+		return nil, ErrAssetMismatch
 	}
 
 	return &AssetAmount{
@@ -58,7 +61,7 @@ func (a *AssetAmount) Add(other *AssetAmount, numOther *uint64) (*AssetAmount, e
 	}
 
 	if !a.Asset.Equal(other.Asset) {
-		return nil, fmt.Errorf("the other asset is mismatched")
+		return nil, ErrAssetMismatch
 	}
 
 	return &AssetAmount{
@@ -80,7 +83,7 @@ func (a *AssetAmount) Sub(other *AssetAmount, numOther *uint64) (*AssetAmount, e
 	}
 
 	if !a.Asset.Equal(other.Asset) {
-		return nil, fmt.Errorf("the other asset is mismatched")
+		return nil, ErrAssetMismatch
 	}
 
 	return &AssetAmount{
@@ -106,7 +109,7 @@ func (a *AssetAmount) Div(other *AssetAmount, numOther *uint64) (*AssetAmount, e
 	}
 
 	if !a.Asset.Equal(other.Asset) {
-		return nil, fmt.Errorf("the other asset is mismatched")
+		return nil, ErrAssetMismatch
 	}
 
 	if other.Amount == 0 {
@@ -128,7 +131,7 @@ func (a *AssetAmount) Gt(other *AssetAmount, numOther *uint64) (bool, error) {
 		return a.Amount > *numOther, nil
 	}
 	if !a.Asset.Equal(other.Asset) {
-		return false, fmt.Errorf("the other asset is mismatched")
+		return false, ErrAssetMismatch
 	}
 
 	return a.Amount > other.Amount, nil
@@ -143,7 +146,7 @@ func (a *AssetAmount) Lt(other *AssetAmount, numOther *uint64) (bool, error) {
 		return a.Amount < *numOther, nil
 	}
 	if !a.Asset.Equal(other.Asset) {
-		return false, fmt.Errorf("the other asset is mismatched")
+		return false, ErrAssetMismatch
 	}
 
 	return a.Amount < other.Amount, nil
@@ -158,12 +161,62 @@ func (a *AssetAmount) Eq(other *AssetAmount, numOther *uint64) (bool, error) {
 		return a.Amount == *numOther, nil
 	}
 	if !a.Asset.Equal(other.Asset) {
-		return false, fmt.Errorf("the other asset is mismatched")
+		return false, ErrAssetMismatch
 	}
 
 	return a.Amount == other.Amount, nil
 }
 
+// MinWithSlippage returns ⌊amount × (10000 - bps) / 10000⌋ as a new one, a slippage above 10000 basis points returns zero
+func (a *AssetAmount) MinWithSlippage(slippageBps uint64) *AssetAmount {
+	if slippageBps >= constants.BasisPoints {
+		return &AssetAmount{Asset: a.Asset}
+	}
+
+	amount := new(big.Int).SetUint64(a.Amount)
+	amount.Mul(amount, new(big.Int).SetUint64(constants.BasisPoints-slippageBps))
+	amount.Quo(amount, big.NewInt(constants.BasisPoints))
+
+	return &AssetAmount{
+		Asset:  a.Asset,
+		Amount: amount.Uint64(),
+	}
+}
+
+// MaxWithSlippage returns ⌈amount × (10000 + bps) / 10000⌉ as a new one
+func (a *AssetAmount) MaxWithSlippage(slippageBps uint64) (*AssetAmount, error) {
+	amount := new(big.Int).SetUint64(a.Amount)
+	amount.Mul(amount, new(big.Int).Add(new(big.Int).SetUint64(slippageBps), big.NewInt(constants.BasisPoints)))
+	amount.Add(amount, big.NewInt(constants.BasisPoints-1))
+	amount.Quo(amount, big.NewInt(constants.BasisPoints))
+	if !amount.IsUint64() {
+		return nil, fmt.Errorf("amount with slippage overflows")
+	}
+
+	return &AssetAmount{
+		Asset:  a.Asset,
+		Amount: amount.Uint64(),
+	}, nil
+}
+
+// checkSlippageBps checks a slippage tolerance in basis points is at most 100%
+func checkSlippageBps(slippageBps uint64) error {
+	if slippageBps > constants.BasisPoints {
+		return fmt.Errorf("slippage %d bps is out of range [0, %d]", slippageBps, constants.BasisPoints)
+	}
+
+	return nil
+}
+
+// SlippageBps converts a slippage fraction, e.g. 0.01 for 1%, to basis points
+func SlippageBps(slippage float64) (uint64, error) {
+	if slippage < 0 || slippage > 1 || math.IsNaN(slippage) {
+		return 0, fmt.Errorf("slippage %f is out of range [0, 1]", slippage)
+	}
+
+	return uint64(math.Round(slippage * constants.BasisPoints)), nil
+}
+
 // String returns a string representing an asest amount
 func (a *AssetAmount) String() string {
 	amount := float64(a.Amount) / (math.Pow(10, float64(a.Asset.Decimals)))
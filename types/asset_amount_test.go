@@ -1,6 +1,8 @@
 package types_test
 
 import (
+	"math/big"
+	"math/rand"
 	"testing"
 
 	"github.com/synycboom/tinyman-go-sdk/types"
@@ -430,3 +432,48 @@ func TestAsetAmountEq(t *testing.T) {
 		return
 	}
 }
+
+func TestAssetAmountWithSlippage(t *testing.T) {
+	asset := types.NewAsset(1, 0, "1", "1")
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		amount := &types.AssetAmount{Asset: asset, Amount: r.Uint64() >> uint(r.Intn(64))}
+		slippageBps := uint64(r.Intn(10001))
+
+		exact := new(big.Int).Mul(new(big.Int).SetUint64(amount.Amount), new(big.Int).SetUint64(10000-slippageBps))
+		min := new(big.Int).Mul(new(big.Int).SetUint64(amount.MinWithSlippage(slippageBps).Amount), big.NewInt(10000))
+		if min.Cmp(exact) > 0 || new(big.Int).Add(min, big.NewInt(10000)).Cmp(exact) <= 0 {
+			t.Fatalf("MinWithSlippage(%d) of %d is not the floor", slippageBps, amount.Amount)
+		}
+
+		exact = new(big.Int).Mul(new(big.Int).SetUint64(amount.Amount), new(big.Int).SetUint64(10000+slippageBps))
+		ceil := new(big.Int).Quo(new(big.Int).Add(exact, big.NewInt(9999)), big.NewInt(10000))
+		maxAmount, err := amount.MaxWithSlippage(slippageBps)
+		if !ceil.IsUint64() {
+			if err == nil {
+				t.Fatalf("MaxWithSlippage(%d) of %d should overflow", slippageBps, amount.Amount)
+			}
+
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+		if maxAmount.Amount != ceil.Uint64() {
+			t.Fatalf("MaxWithSlippage(%d) of %d is not the ceiling", slippageBps, amount.Amount)
+		}
+	}
+}
+
+func TestSlippageBps(t *testing.T) {
+	bps, err := types.SlippageBps(0.0123)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if bps != 123 {
+		t.Errorf("SlippageBps returned wrong basis points %d", bps)
+	}
+	if _, err := types.SlippageBps(1.5); err == nil {
+		t.Errorf("SlippageBps should return an error when slippage is out of range")
+	}
+}
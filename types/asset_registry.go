@@ -0,0 +1,267 @@
+package types
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+)
+
+// DefaultAssetRegistrySize is the number of assets an asset registry keeps when no size is given
+const DefaultAssetRegistrySize = 1024
+
+// assetFetchTimeout bounds a shared lookup, which is detached from the contexts of its callers
+const assetFetchTimeout = 30 * time.Second
+
+// AssetRegistry is an asset cache which can be shared by clients, pools and goroutines.
+// It wraps an AlgodAPI and implements AlgodAPI itself, GetAssetByID is served from the cache and concurrent lookups of the same asset share a single request.
+// The least recently used asset is evicted when the registry is full, and an asset expires a given time after it was stored.
+type AssetRegistry struct {
+	ac AlgodAPI
+
+	mu       sync.Mutex
+	entries  map[uint64]*list.Element
+	recency  *list.List
+	inflight map[uint64]*assetCall
+
+	// maxSize is the number of assets the registry keeps
+	maxSize int
+
+	// ttl is the time an asset stays cached after it was stored, zero means no time limit
+	ttl time.Duration
+}
+
+type assetEntry struct {
+	asset    models.Asset
+	storedAt time.Time
+}
+
+// assetCall is an in-flight lookup which concurrent callers of the same asset wait for
+type assetCall struct {
+	done  chan struct{}
+	asset models.Asset
+	err   error
+}
+
+// assetRecord is an asset in a preload file
+type assetRecord struct {
+	ID       uint64 `json:"id"`
+	Decimals uint64 `json:"decimals"`
+	Name     string `json:"name"`
+	UnitName string `json:"unit_name"`
+}
+
+// NewAssetRegistry creates an asset registry on top of an algod client which keeps at most maxSize assets for ttl each,
+// a maxSize of zero means DefaultAssetRegistrySize and a ttl of zero means no time limit
+func NewAssetRegistry(ac AlgodAPI, maxSize int, ttl time.Duration) *AssetRegistry {
+	if maxSize <= 0 {
+		maxSize = DefaultAssetRegistrySize
+	}
+
+	return &AssetRegistry{
+		ac:       ac,
+		entries:  make(map[uint64]*list.Element),
+		recency:  list.New(),
+		inflight: make(map[uint64]*assetCall),
+		maxSize:  maxSize,
+		ttl:      ttl,
+	}
+}
+
+// Algod returns the wrapped algod client, optional APIs such as StatusAPI are implemented by it rather than by the registry
+func (r *AssetRegistry) Algod() AlgodAPI {
+	return r.ac
+}
+
+// Asset returns a fetched asset of a given asset id, ALGO is returned without a lookup
+func (r *AssetRegistry) Asset(ctx context.Context, assetID uint64) (*Asset, error) {
+	asset := Asset{ID: assetID}
+	if err := asset.Fetch(ctx, r); err != nil {
+		return nil, err
+	}
+
+	return &asset, nil
+}
+
+// Put stores an asset in the registry
+func (r *AssetRegistry) Put(asset models.Asset) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.put(asset)
+}
+
+// Forget removes given assets from the registry
+func (r *AssetRegistry) Forget(assetIDs ...uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, assetID := range assetIDs {
+		if elem, ok := r.entries[assetID]; ok {
+			r.recency.Remove(elem)
+			delete(r.entries, assetID)
+		}
+	}
+}
+
+// Len returns the number of cached assets including expired ones which are not evicted yet
+func (r *AssetRegistry) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.entries)
+}
+
+// Load preloads assets from a JSON array of objects with id, decimals, name and unit_name fields
+func (r *AssetRegistry) Load(reader io.Reader) error {
+	var records []assetRecord
+	if err := json.NewDecoder(reader).Decode(&records); err != nil {
+		return fmt.Errorf("failed to decode assets: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, record := range records {
+		r.put(models.Asset{
+			Index: record.ID,
+			Params: models.AssetParams{
+				Decimals: record.Decimals,
+				Name:     record.Name,
+				UnitName: record.UnitName,
+			},
+		})
+	}
+
+	return nil
+}
+
+// LoadFile preloads assets from a JSON file, see Load for the format
+func (r *AssetRegistry) LoadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return r.Load(file)
+}
+
+// GetAssetByID returns asset information of a given asset id from the cache, or fetches it once for all concurrent callers
+func (r *AssetRegistry) GetAssetByID(ctx context.Context, assetID uint64) (models.Asset, error) {
+	if assetID == 0 {
+		return models.Asset{
+			Params: models.AssetParams{
+				Decimals: constants.AlgoTokenDecimals,
+				Name:     constants.AlgoTokenName,
+				UnitName: constants.AlgoTokenUnitName,
+			},
+		}, nil
+	}
+
+	r.mu.Lock()
+	if asset, ok := r.get(assetID); ok {
+		r.mu.Unlock()
+
+		return asset, nil
+	}
+
+	call, ok := r.inflight[assetID]
+	if !ok {
+		call = &assetCall{done: make(chan struct{})}
+		r.inflight[assetID] = call
+		go r.fetch(assetID, call)
+	}
+	r.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.asset, call.err
+	case <-ctx.Done():
+		return models.Asset{}, ctx.Err()
+	}
+}
+
+// AccountInformation returns account information of a given address from the wrapped client
+func (r *AssetRegistry) AccountInformation(ctx context.Context, address string) (models.Account, error) {
+	return r.ac.AccountInformation(ctx, address)
+}
+
+// SuggestedParams returns the suggested parameters from the wrapped client
+func (r *AssetRegistry) SuggestedParams(ctx context.Context) (types.SuggestedParams, error) {
+	return r.ac.SuggestedParams(ctx)
+}
+
+// SendRawTransaction submits encoded signed transactions through the wrapped client
+func (r *AssetRegistry) SendRawTransaction(ctx context.Context, rawTxn []byte) (string, error) {
+	return r.ac.SendRawTransaction(ctx, rawTxn)
+}
+
+// PendingTransactionInformation returns information of a pending or recently confirmed transaction from the wrapped client
+func (r *AssetRegistry) PendingTransactionInformation(ctx context.Context, txID string) (models.PendingTransactionInfoResponse, error) {
+	return r.ac.PendingTransactionInformation(ctx, txID)
+}
+
+// fetch looks an asset up for an in-flight call. The lookup is not bound to a caller's context so that one cancelled caller
+// does not fail the others, it has its own deadline instead so that a stuck node does not keep the call in flight forever.
+func (r *AssetRegistry) fetch(assetID uint64, call *assetCall) {
+	ctx, cancel := context.WithTimeout(context.Background(), assetFetchTimeout)
+	defer cancel()
+
+	call.asset, call.err = r.ac.GetAssetByID(ctx, assetID)
+
+	r.mu.Lock()
+	if call.err == nil {
+		r.put(call.asset)
+	}
+	delete(r.inflight, assetID)
+	r.mu.Unlock()
+
+	close(call.done)
+}
+
+// get returns a cached asset and marks it as recently used, an expired asset is evicted, the lock has to be held
+func (r *AssetRegistry) get(assetID uint64) (models.Asset, bool) {
+	elem, ok := r.entries[assetID]
+	if !ok {
+		return models.Asset{}, false
+	}
+
+	entry := elem.Value.(*assetEntry)
+	if r.ttl > 0 && time.Since(entry.storedAt) > r.ttl {
+		r.recency.Remove(elem)
+		delete(r.entries, assetID)
+
+		return models.Asset{}, false
+	}
+
+	r.recency.MoveToFront(elem)
+
+	return entry.asset, true
+}
+
+// put stores an asset and evicts the least recently used assets over the size limit, the lock has to be held
+func (r *AssetRegistry) put(asset models.Asset) {
+	entry := &assetEntry{asset: asset, storedAt: time.Now()}
+	if elem, ok := r.entries[asset.Index]; ok {
+		elem.Value = entry
+		r.recency.MoveToFront(elem)
+	} else {
+		r.entries[asset.Index] = r.recency.PushFront(entry)
+	}
+
+	for r.recency.Len() > r.maxSize {
+		oldest := r.recency.Back()
+		r.recency.Remove(oldest)
+		delete(r.entries, oldest.Value.(*assetEntry).asset.Index)
+	}
+}
@@ -0,0 +1,142 @@
+package types_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/v1/algodtest"
+)
+
+// countingNode counts asset lookups and holds them until release is closed
+type countingNode struct {
+	*algodtest.Node
+
+	lookups   int32
+	deadlines int32
+	release   chan struct{}
+}
+
+func (n *countingNode) GetAssetByID(ctx context.Context, assetID uint64) (models.Asset, error) {
+	atomic.AddInt32(&n.lookups, 1)
+	if _, ok := ctx.Deadline(); ok {
+		atomic.AddInt32(&n.deadlines, 1)
+	}
+	<-n.release
+
+	return n.Node.GetAssetByID(ctx, assetID)
+}
+
+func TestAssetRegistry(t *testing.T) {
+	ctx := context.Background()
+	node := &countingNode{Node: algodtest.NewNode(), release: make(chan struct{})}
+	node.SetAsset(10, 6, "USD Coin", "USDC")
+	node.SetAsset(11, 2, "Euro", "EUR")
+	node.SetAsset(12, 0, "Token", "TOK")
+	registry := types.NewAssetRegistry(node, 2, 0)
+
+	var wg sync.WaitGroup
+	assets := make([]*types.Asset, 8)
+	for i := range assets {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			asset, err := registry.Asset(ctx, 10)
+			if err != nil {
+				t.Errorf("Unexpected err %s", err.Error())
+
+				return
+			}
+
+			assets[i] = asset
+		}(i)
+	}
+
+	// Wait until every caller is blocked on the single lookup before releasing it
+	time.Sleep(20 * time.Millisecond)
+	close(node.release)
+	wg.Wait()
+
+	if lookups := atomic.LoadInt32(&node.lookups); lookups != 1 {
+		t.Fatalf("Expected concurrent callers to share 1 lookup, got %d", lookups)
+	}
+	for _, asset := range assets {
+		if asset == nil || asset.UnitName != "USDC" || asset.Decimals != 6 {
+			t.Fatalf("Expected USDC with 6 decimals, got %v", asset)
+		}
+	}
+
+	if _, err := registry.Asset(ctx, 11); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if _, err := registry.Asset(ctx, 12); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if registry.Len() != 2 {
+		t.Fatalf("Expected 2 cached assets, got %d", registry.Len())
+	}
+	if _, err := registry.Asset(ctx, 10); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if lookups := atomic.LoadInt32(&node.lookups); lookups != 4 {
+		t.Fatalf("Expected the least recently used asset to be evicted, got %d lookups", lookups)
+	}
+
+	algo, err := registry.Asset(ctx, 0)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if algo.UnitName != "ALGO" {
+		t.Fatalf("Expected ALGO, got %s", algo.UnitName)
+	}
+
+	preloaded := types.NewAssetRegistry(node, 0, time.Millisecond)
+	if err := preloaded.Load(strings.NewReader(`[{"id": 31566704, "decimals": 6, "name": "USDC", "unit_name": "USDC"}]`)); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	asset, err := preloaded.Asset(ctx, 31566704)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if asset.Name != "USDC" || asset.Decimals != 6 {
+		t.Fatalf("Expected the preloaded asset, got %v", asset)
+	}
+
+	time.Sleep(5 * time.Millisecond)
+	if _, err := preloaded.Asset(ctx, 31566704); err == nil {
+		t.Fatalf("Expected an expired asset to be looked up again and fail on the node")
+	}
+}
+
+func TestAssetRegistryCancelledWaiter(t *testing.T) {
+	node := &countingNode{Node: algodtest.NewNode(), release: make(chan struct{})}
+	node.SetAsset(10, 6, "USDC", "USDC")
+	registry := types.NewAssetRegistry(node, 0, 0)
+
+	// a waiter returns on its own cancellation while the shared lookup goes on
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	if _, err := registry.Asset(ctx, 10); err != context.DeadlineExceeded {
+		t.Fatalf("Expected the waiter to return on its own deadline, got %v", err)
+	}
+
+	close(node.release)
+	asset, err := registry.Asset(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if asset.Name != "USDC" {
+		t.Errorf("Asset returned wrong asset %v", asset)
+	}
+	if lookups, deadlines := atomic.LoadInt32(&node.lookups), atomic.LoadInt32(&node.deadlines); lookups != 1 || deadlines != 1 {
+		t.Errorf("Expected one lookup with a deadline, got %d lookups and %d deadlines", lookups, deadlines)
+	}
+}
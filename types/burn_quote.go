@@ -8,27 +8,42 @@ type BurnQuote struct {
 	// LiquidityAssetAmount is a liquidity asset amount
 	LiquidityAssetAmount AssetAmount
 
-	// Slippage is a slippage
-	Slippage float64
+	// SlippageBps is a slippage tolerance in basis points, 100 is 1%
+	SlippageBps uint64
+
+	// MinAmountsOut is an asset mapping which maps between asset ids and the minimum output asset amounts accepted by the burn
+	MinAmountsOut map[uint64]AssetAmount
 }
 
-// AmountsOutWithSlippage calculates asset amount out after applying the slippage
+// AmountsOutWithSlippage returns the minimum output asset amounts,
+// they are calculated from the slippage when MinAmountsOut is not set
 func (b *BurnQuote) AmountsOutWithSlippage() (map[uint64]AssetAmount, error) {
+	if b.MinAmountsOut != nil {
+		return b.MinAmountsOut, nil
+	}
+
 	out := make(map[uint64]AssetAmount)
 	for k := range b.AmountsOut {
 		amountOut := b.AmountsOut[k]
-		amountWithSlippage, err := amountOut.Mul(nil, &b.Slippage)
-		if err != nil {
-			return nil, err
-		}
+		out[k] = *amountOut.MinWithSlippage(b.SlippageBps)
+	}
+
+	return out, nil
+}
 
-		res, err := amountOut.Sub(amountWithSlippage, nil)
-		if err != nil {
-			return nil, err
-		}
+// SetBounds sets MinAmountsOut from the slippage
+func (b *BurnQuote) SetBounds() error {
+	if err := checkSlippageBps(b.SlippageBps); err != nil {
+		return err
+	}
 
-		out[k] = *res
+	b.MinAmountsOut = nil
+	amounts, err := b.AmountsOutWithSlippage()
+	if err != nil {
+		return err
 	}
 
-	return out, nil
+	b.MinAmountsOut = amounts
+
+	return nil
 }
@@ -1,9 +1,56 @@
 package types
 
-import "context"
+import (
+	"context"
 
-// AlgoClient represents the Algorand client
-type AlgoClient interface {
-	// SendRawTransaction submit a signed transaction group and wait for it to be confirmed if wait is true
-	SendRawTransaction(ctx context.Context, signedGroup []byte, wait bool) (string, error)
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+// AlgodAPI represents the subset of the Algorand node API used by the SDK
+type AlgodAPI interface {
+	// AccountInformation returns account information of a given address
+	AccountInformation(ctx context.Context, address string) (models.Account, error)
+
+	// GetAssetByID returns asset information of a given asset id
+	GetAssetByID(ctx context.Context, assetID uint64) (models.Asset, error)
+
+	// SuggestedParams returns the suggested parameters for constructing a new transaction
+	SuggestedParams(ctx context.Context) (types.SuggestedParams, error)
+
+	// SendRawTransaction submits encoded signed transactions and returns the id of the first transaction
+	SendRawTransaction(ctx context.Context, rawTxn []byte) (string, error)
+
+	// PendingTransactionInformation returns information of a pending or recently confirmed transaction
+	PendingTransactionInformation(ctx context.Context, txID string) (models.PendingTransactionInfoResponse, error)
+}
+
+// IndexerAPI represents the subset of the Algorand indexer API used by the SDK
+type IndexerAPI interface {
+	// SearchAccountsByApp returns a page of accounts opted into a given application
+	SearchAccountsByApp(ctx context.Context, appID, limit uint64, next string) (models.AccountsResponse, error)
+}
+
+// DryrunAPI represents the Algorand node dryrun endpoint, an AlgodAPI may implement it as well
+type DryrunAPI interface {
+	// GetApplicationByID returns application information of a given application id
+	GetApplicationByID(ctx context.Context, appID uint64) (models.Application, error)
+
+	// TealDryrun executes transactions against a given ledger state without committing them
+	TealDryrun(ctx context.Context, request models.DryrunRequest) (models.DryrunResponse, error)
+}
+
+// StatusAPI represents the Algorand node status endpoints, an AlgodAPI may implement it as well
+type StatusAPI interface {
+	// Status returns the current status of the node
+	Status(ctx context.Context) (models.NodeStatus, error)
+
+	// StatusAfterBlock waits for a block after a given round and returns the status of the node
+	StatusAfterBlock(ctx context.Context, round uint64) (models.NodeStatus, error)
+}
+
+// BlockAPI represents the Algorand node block endpoint, an AlgodAPI may implement it as well
+type BlockAPI interface {
+	// Block returns a confirmed block of a given round
+	Block(ctx context.Context, round uint64) (types.Block, error)
 }
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DryrunReport represents a result of dry-running a transaction group
+type DryrunReport struct {
+	// Passed is true when every transaction of the group passes
+	Passed bool
+
+	// Error is an error returned by the dryrun endpoint
+	Error string
+
+	// Transactions are results of the transactions in the group order
+	Transactions []DryrunTransaction
+
+	// Transfers are asset transfers of the group, they are applied only when the group passes
+	Transfers []Transfer
+}
+
+// FirstFailure returns the first transaction which does not pass, or nil if there is none
+func (r *DryrunReport) FirstFailure() *DryrunTransaction {
+	for idx := range r.Transactions {
+		if !r.Transactions[idx].Passed {
+			return &r.Transactions[idx]
+		}
+	}
+
+	return nil
+}
+
+// DryrunTransaction represents a dryrun result of a transaction
+type DryrunTransaction struct {
+	// TxID is the id of the transaction
+	TxID string
+
+	// GroupIndex is the index of the transaction in the group
+	GroupIndex int
+
+	// Passed is true when the app call and the logic signature of the transaction pass
+	Passed bool
+
+	// AppCallMessages are messages of the app call evaluation, e.g. PASS or REJECT
+	AppCallMessages []string
+
+	// LogicSigMessages are messages of the logic signature evaluation
+	LogicSigMessages []string
+
+	// Logs are logs emitted by the app call
+	Logs [][]byte
+
+	// Cost is an opcode cost of the app call
+	Cost uint64
+
+	// LocalDeltas are local state changes of accounts made by the app call, e.g. the pool and the user
+	LocalDeltas []LocalStateDelta
+
+	// GlobalDelta are global state changes made by the app call
+	GlobalDelta []StateDelta
+}
+
+// LocalStateDelta represents local state changes of an account
+type LocalStateDelta struct {
+	// Address is an account address
+	Address string
+
+	// Deltas are changes of the local state
+	Deltas []StateDelta
+}
+
+// StateDelta represents a change of an application state key
+type StateDelta struct {
+	// Key is a raw state key
+	Key []byte
+
+	// Action is 1 for setting bytes, 2 for setting uint and 3 for deleting the key
+	Action uint64
+
+	// Bytes is a new bytes value
+	Bytes []byte
+
+	// Uint is a new uint value
+	Uint uint64
+}
+
+// Transfer represents an asset transfer, asset id 0 is Algo
+type Transfer struct {
+	// Sender is a sender address
+	Sender string
+
+	// Receiver is a receiver address
+	Receiver string
+
+	// AssetID is an asset id
+	AssetID uint64
+
+	// Amount is a transferred amount
+	Amount uint64
+}
+
+// DryrunFailedError is returned when a transaction group does not pass a dryrun
+type DryrunFailedError struct {
+	// Report is the dryrun report of the group
+	Report *DryrunReport
+}
+
+// Error returns an error message
+func (e *DryrunFailedError) Error() string {
+	if len(e.Report.Error) > 0 {
+		return fmt.Sprintf("dryrun failed: %s", e.Report.Error)
+	}
+
+	failure := e.Report.FirstFailure()
+	if failure == nil {
+		return "dryrun failed"
+	}
+
+	messages := append(append([]string{}, failure.LogicSigMessages...), failure.AppCallMessages...)
+
+	return fmt.Sprintf("dryrun failed at transaction %d: %s", failure.GroupIndex, strings.Join(messages, ", "))
+}
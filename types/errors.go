@@ -0,0 +1,67 @@
+package types
+
+import (
+	"errors"
+)
+
+var (
+	// ErrPoolNotBootstrapped is returned when a pool has not been bootstrapped yet
+	ErrPoolNotBootstrapped = errors.New("pool has not been bootstrapped yet")
+
+	// ErrInsufficientLiquidity is returned when a pool does not have enough liquidity for an operation
+	ErrInsufficientLiquidity = errors.New("pool does not have enough liquidity")
+
+	// ErrSlippageExceeded is returned when the validator app rejects an amount outside of the slippage bounds
+	ErrSlippageExceeded = errors.New("slippage exceeded")
+
+	// ErrNotOptedIn is returned when an account has not opted in to the validator app or an asset
+	ErrNotOptedIn = errors.New("account has not opted in")
+
+	// ErrAssetMismatch is returned when an asset does not match the expected one
+	ErrAssetMismatch = errors.New("asset mismatch")
+
+	// ErrInsufficientBalance is returned when an account does not have enough balance to send
+	ErrInsufficientBalance = errors.New("account does not have enough balance")
+
+	// ErrExcessOutstanding is returned when opting out would discard excess amounts which are not redeemed yet
+	ErrExcessOutstanding = errors.New("account has excess amounts which are not redeemed")
+)
+
+// RejectedError is a transaction group rejected by algod
+type RejectedError struct {
+	// TxID is the id of the rejected transaction, it is empty when algod does not report it
+	TxID string
+
+	// GroupIndex is the index of the rejected transaction in its group, it is -1 when unknown
+	GroupIndex int
+
+	// AppID is the id of the application which rejected the transaction, it is 0 when unknown
+	AppID uint64
+
+	// PC is the program counter of a failed logic evaluation, it is 0 when unknown
+	PC uint64
+
+	// Reason is the rejection reason reported by algod
+	Reason string
+
+	// Err is one of the SDK errors which the rejection maps to, it is nil when the rejection is not recognized
+	Err error
+
+	// Cause is the original error returned by algod
+	Cause error
+}
+
+// Error returns an error message
+func (e *RejectedError) Error() string {
+	return e.Cause.Error()
+}
+
+// Unwrap returns the original error returned by algod
+func (e *RejectedError) Unwrap() error {
+	return e.Cause
+}
+
+// Is reports whether the rejection maps to a given SDK error
+func (e *RejectedError) Is(target error) bool {
+	return e.Err != nil && e.Err == target
+}
@@ -8,16 +8,36 @@ type MintQuote struct {
 	// LiquidityAssetAmount is a liquidity asset amount
 	LiquidityAssetAmount AssetAmount
 
-	// Slippage is a slippage
-	Slippage float64
+	// SlippageBps is a slippage tolerance in basis points, 100 is 1%
+	SlippageBps uint64
+
+	// MinLiquidityAssetAmount is the minimum liquidity asset amount accepted by the mint
+	MinLiquidityAssetAmount *AssetAmount
 }
 
-// LiquidityAssetAmountWithSlippage calculates liquidity asset after applying the slippage
+// LiquidityAssetAmountWithSlippage returns the minimum liquidity asset amount,
+// it is calculated from the slippage when MinLiquidityAssetAmount is not set
 func (m *MintQuote) LiquidityAssetAmountWithSlippage() (*AssetAmount, error) {
-	amountWithSlippage, err := m.LiquidityAssetAmount.Mul(nil, &m.Slippage)
+	if m.MinLiquidityAssetAmount != nil {
+		return m.MinLiquidityAssetAmount, nil
+	}
+
+	return m.LiquidityAssetAmount.MinWithSlippage(m.SlippageBps), nil
+}
+
+// SetBounds sets MinLiquidityAssetAmount from the slippage
+func (m *MintQuote) SetBounds() error {
+	if err := checkSlippageBps(m.SlippageBps); err != nil {
+		return err
+	}
+
+	m.MinLiquidityAssetAmount = nil
+	amount, err := m.LiquidityAssetAmountWithSlippage()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return m.LiquidityAssetAmount.Sub(amountWithSlippage, nil)
+	m.MinLiquidityAssetAmount = amount
+
+	return nil
 }
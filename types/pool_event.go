@@ -0,0 +1,158 @@
+package types
+
+// Action is a decoded Tinyman transaction group,
+// it is one of *BootstrapEvent, *SwapEvent, *MintEvent, *BurnEvent, *RedeemEvent, *FeesEvent and *UnknownEvent
+type Action interface {
+	// Event returns the part of the action shared by every action
+	Event() *PoolEvent
+}
+
+// PoolEvent is the part of a decoded Tinyman transaction group shared by every action
+type PoolEvent struct {
+	// Type is one of the constants.Action types
+	Type string
+
+	// Round is the round the group was confirmed at
+	Round uint64
+
+	// TxID is the id of the validator app call of the group
+	TxID string
+
+	// GroupID is the base64 encoded group id
+	GroupID string
+
+	// Sender is the address of the user who sent the group
+	Sender string
+
+	// PoolAddress is the address of the pool
+	PoolAddress string
+
+	// ValidatorAppID is the validator app id of the pool
+	ValidatorAppID uint64
+
+	// Asset1ID is the asset1 id of the pool
+	Asset1ID uint64
+
+	// Asset2ID is the asset2 id of the pool
+	Asset2ID uint64
+}
+
+// Event returns the pool event itself
+func (e *PoolEvent) Event() *PoolEvent {
+	return e
+}
+
+// BootstrapEvent represents a pool bootstrap
+type BootstrapEvent struct {
+	PoolEvent
+
+	// FundingAmount is the ALGO amount the user paid to fund the pool
+	FundingAmount uint64
+
+	// LiquidityAssetID is the created liquidity asset id, it is only known when decoding a block
+	LiquidityAssetID uint64
+}
+
+// SwapEvent represents a swap
+type SwapEvent struct {
+	PoolEvent
+
+	// SwapType is constants.SwapFixedInput or constants.SwapFixedOutput
+	SwapType string
+
+	// AssetInID is the id of the asset sent to the pool
+	AssetInID uint64
+
+	// AmountIn is the amount swapped, for a fixed output swap decoded from a block it excludes unused input left as excess
+	AmountIn uint64
+
+	// AssetOutID is the id of the asset sent to the user
+	AssetOutID uint64
+
+	// AmountOut is the amount swapped, for a fixed input swap decoded from a block it includes output left as excess
+	AmountOut uint64
+
+	// Excess is the amount the swap left in the pool as excess of the user, in the asset out for a fixed input swap
+	// and in the asset in for a fixed output swap, it is only known when decoding a block
+	Excess uint64
+}
+
+// MintEvent represents a mint
+type MintEvent struct {
+	PoolEvent
+
+	// Asset1Amount is the asset1 amount sent to the pool
+	Asset1Amount uint64
+
+	// Asset2Amount is the asset2 amount sent to the pool
+	Asset2Amount uint64
+
+	// LiquidityAssetID is the liquidity asset id of the pool
+	LiquidityAssetID uint64
+
+	// LiquidityAssetAmount is the minted liquidity asset amount, when decoded from a block it includes the amount left as excess
+	LiquidityAssetAmount uint64
+
+	// LiquidityAssetExcess is the liquidity asset amount left in the pool as excess of the user, it is only known when decoding a block
+	LiquidityAssetExcess uint64
+}
+
+// BurnEvent represents a burn
+type BurnEvent struct {
+	PoolEvent
+
+	// Asset1Amount is the burned asset1 amount, when decoded from a block it includes the amount left as excess
+	Asset1Amount uint64
+
+	// Asset2Amount is the burned asset2 amount, when decoded from a block it includes the amount left as excess
+	Asset2Amount uint64
+
+	// Asset1Excess is the asset1 amount left in the pool as excess of the user, it is only known when decoding a block
+	Asset1Excess uint64
+
+	// Asset2Excess is the asset2 amount left in the pool as excess of the user, it is only known when decoding a block
+	Asset2Excess uint64
+
+	// LiquidityAssetID is the liquidity asset id of the pool
+	LiquidityAssetID uint64
+
+	// LiquidityAssetAmount is the liquidity asset amount sent to the pool
+	LiquidityAssetAmount uint64
+}
+
+// RedeemEvent represents a redeem of an excess amount
+type RedeemEvent struct {
+	PoolEvent
+
+	// AssetID is the id of the redeemed asset
+	AssetID uint64
+
+	// Amount is the redeemed amount
+	Amount uint64
+}
+
+// FeesEvent represents a protocol fee claim
+type FeesEvent struct {
+	PoolEvent
+
+	// LiquidityAssetID is the liquidity asset id of the pool
+	LiquidityAssetID uint64
+
+	// Amount is the claimed liquidity asset amount
+	Amount uint64
+
+	// Receiver is the address which received the claimed fees
+	Receiver string
+}
+
+// UnknownEvent represents a group calling the validator app which could not be decoded,
+// pool assets are unknown and the pool address is the sender of the app call
+type UnknownEvent struct {
+	PoolEvent
+
+	// Operation is the first argument of the validator app call
+	Operation string
+
+	// Reason is why the group could not be decoded
+	Reason string
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+)
+
+// RouteHop represents a single swap of a multi-hop route
+type RouteHop struct {
+	// PoolAddress is an address of the pool used by the hop
+	PoolAddress string
+
+	// Asset1ID is an asset1 id of the pool
+	Asset1ID uint64
+
+	// Asset2ID is an asset2 id of the pool
+	Asset2ID uint64
+
+	// LiquidityAssetID is a liquidity asset id of the pool
+	LiquidityAssetID uint64
+
+	// Quote is a fixed input swap quote of the hop, its PriceImpactBps is the price impact of the hop
+	Quote *SwapQuote
+}
+
+// RouteQuote represents a quote of a swap routed through one or more pools
+type RouteQuote struct {
+	// Hops are swaps in the order they are executed
+	Hops []RouteHop
+
+	// AmountIn is an input asset amount of the first hop
+	AmountIn *AssetAmount
+
+	// AmountOut is an output asset amount of the last hop
+	AmountOut *AssetAmount
+
+	// SlippageBps is a slippage tolerance in basis points applied to the final output, 100 is 1%
+	SlippageBps uint64
+}
+
+// AmountOutWithSlippage calculates the final output asset amount after applying the slippage
+func (r *RouteQuote) AmountOutWithSlippage() (*AssetAmount, error) {
+	if r.AmountOut == nil {
+		return nil, fmt.Errorf("route has no output")
+	}
+
+	if err := checkSlippageBps(r.SlippageBps); err != nil {
+		return nil, err
+	}
+
+	return r.AmountOut.MinWithSlippage(r.SlippageBps), nil
+}
+
+// HopSlippageBps returns the slippage tolerance of an intermediate hop in basis points.
+// Every hop gets an equal integer share of the route slippage, so the compounded slippage of all hops never exceeds it.
+func (r *RouteQuote) HopSlippageBps() uint64 {
+	if len(r.Hops) == 0 {
+		return r.SlippageBps
+	}
+
+	return r.SlippageBps / uint64(len(r.Hops))
+}
+
+// HopAmountOutWithSlippage calculates the minimum output of a hop from its quote, the last hop accepts the route minimum output
+func (r *RouteQuote) HopAmountOutWithSlippage(index int) (*AssetAmount, error) {
+	if index < 0 || index >= len(r.Hops) {
+		return nil, fmt.Errorf("index is out of bound")
+	}
+	if index == len(r.Hops)-1 {
+		return r.AmountOutWithSlippage()
+	}
+	if err := checkSlippageBps(r.SlippageBps); err != nil {
+		return nil, err
+	}
+
+	return r.Hops[index].Quote.AmountOut.MinWithSlippage(r.HopSlippageBps()), nil
+}
+
+// Price returns the final output amount per input amount
+func (r *RouteQuote) Price() float64 {
+	return float64(r.AmountOut.Amount) / float64(r.AmountIn.Amount)
+}
+
+// PriceImpactBps returns how far the route execution price is below the compounded spot prices of its pools in basis points
+func (r *RouteQuote) PriceImpactBps() float64 {
+	remaining := 1.0
+	for _, hop := range r.Hops {
+		remaining *= 1 - hop.Quote.PriceImpactBps/constants.BasisPoints
+	}
+
+	return (1 - remaining) * constants.BasisPoints
+}
+
+// SwapFees returns swap fees paid in every hop
+func (r *RouteQuote) SwapFees() []*AssetAmount {
+	fees := make([]*AssetAmount, len(r.Hops))
+	for idx, hop := range r.Hops {
+		fees[idx] = hop.Quote.SwapFee
+	}
+
+	return fees
+}
@@ -18,44 +18,77 @@ type SwapQuote struct {
 	// SwapFee is a swap fee
 	SwapFee *AssetAmount
 
-	// Slippage is a slippage
-	Slippage float64
+	// SlippageBps is a slippage tolerance in basis points, 100 is 1%
+	SlippageBps uint64
+
+	// MinAmountOut is the minimum output asset amount accepted by the swap, it equals AmountOut for a fixed-output swap
+	MinAmountOut *AssetAmount
+
+	// MaxAmountIn is the maximum input asset amount sent by the swap, it equals AmountIn for a fixed-input swap
+	MaxAmountIn *AssetAmount
+
+	// SpotPrice is the pool price of the input asset in base units of the output asset before the swap
+	SpotPrice float64
+
+	// ExecutionPrice is the price of the input asset in base units of the output asset paid by the swap, including the swap fee
+	ExecutionPrice float64
+
+	// PriceImpactBps is how far the execution price is below the spot price in basis points
+	PriceImpactBps float64
+
+	// ReservesAfter is an asset mapping which maps between asset ids and the pool reserves after the swap
+	ReservesAfter map[uint64]AssetAmount
+
+	// SwapFeeOut is the swap fee expressed in the output asset at the spot price
+	SwapFeeOut *AssetAmount
 }
 
-// AmountOutWithSlippage calculates the output asset amount after applying the slippage
+// AmountOutWithSlippage returns the minimum output asset amount, it is calculated from the slippage when MinAmountOut is not set
 func (s *SwapQuote) AmountOutWithSlippage() (*AssetAmount, error) {
+	if s.MinAmountOut != nil {
+		return s.MinAmountOut, nil
+	}
 	if s.SwapType == constants.SwapFixedOutput {
 		return s.AmountOut, nil
 	}
 
-	amountOutWithSlippage, err := s.AmountOut.Mul(nil, &s.Slippage)
-	if err != nil {
-		return nil, err
-	}
-	amount, err := s.AmountOut.Sub(amountOutWithSlippage, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return amount, nil
+	return s.AmountOut.MinWithSlippage(s.SlippageBps), nil
 }
 
-// AmountInWithSlippage calculates the input asset amount after applying the slippage
+// AmountInWithSlippage returns the maximum input asset amount, it is calculated from the slippage when MaxAmountIn is not set
 func (s *SwapQuote) AmountInWithSlippage() (*AssetAmount, error) {
+	if s.MaxAmountIn != nil {
+		return s.MaxAmountIn, nil
+	}
 	if s.SwapType == constants.SwapFixedInput {
 		return s.AmountIn, nil
 	}
 
-	amountInWithSlippage, err := s.AmountIn.Mul(nil, &s.Slippage)
+	return s.AmountIn.MaxWithSlippage(s.SlippageBps)
+}
+
+// SetBounds sets MinAmountOut and MaxAmountIn from the slippage
+func (s *SwapQuote) SetBounds() error {
+	if err := checkSlippageBps(s.SlippageBps); err != nil {
+		return err
+	}
+
+	s.MinAmountOut = nil
+	s.MaxAmountIn = nil
+
+	minAmountOut, err := s.AmountOutWithSlippage()
 	if err != nil {
-		return nil, err
+		return err
 	}
-	amount, err := s.AmountIn.Add(amountInWithSlippage, nil)
+	maxAmountIn, err := s.AmountInWithSlippage()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return amount, nil
+	s.MinAmountOut = minAmountOut
+	s.MaxAmountIn = maxAmountIn
+
+	return nil
 }
 
 // Price returns the price
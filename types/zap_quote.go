@@ -0,0 +1,43 @@
+package types
+
+// ZapInQuote represents a quote which provides liquidity from a single asset by swapping a part of it for the other asset first
+type ZapInQuote struct {
+	// AmountIn is the single asset amount provided
+	AmountIn AssetAmount
+
+	// SwapQuote swaps a part of the input for the other asset of the pool
+	SwapQuote *SwapQuote
+
+	// MintQuote mints liquidity from the rest of the input and the minimum output of the swap against the reserves after the swap
+	MintQuote *MintQuote
+
+	// LiquidityAssetAmount is the expected liquidity asset amount, including liquidity left as excess by the mint
+	LiquidityAssetAmount AssetAmount
+
+	// MinLiquidityAssetAmount is the worst-case liquidity asset amount accepted by the mint
+	MinLiquidityAssetAmount AssetAmount
+
+	// Excess is an asset mapping which maps between asset ids and amounts the swap and the mint are expected to leave as excess
+	Excess map[uint64]AssetAmount
+}
+
+// ZapOutQuote represents a quote which burns liquidity into a single asset by swapping the other asset of the pool afterwards
+type ZapOutQuote struct {
+	// LiquidityAssetAmount is the liquidity asset amount burned
+	LiquidityAssetAmount AssetAmount
+
+	// BurnQuote burns the liquidity asset amount
+	BurnQuote *BurnQuote
+
+	// SwapQuote swaps the other asset received by the burn for the chosen asset against the reserves after the burn
+	SwapQuote *SwapQuote
+
+	// AmountOut is the expected amount of the chosen asset received by the burn and the swap
+	AmountOut AssetAmount
+
+	// MinAmountOut is the worst-case amount of the chosen asset accepted by the burn and the swap
+	MinAmountOut AssetAmount
+
+	// Excess is an asset mapping which maps between asset ids and amounts the burn and the swap are expected to leave as excess
+	Excess map[uint64]AssetAmount
+}
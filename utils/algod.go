@@ -0,0 +1,140 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	algoTypes "github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+)
+
+// algodClient adapts an algod client to the AlgodAPI interface
+type algodClient struct {
+	ac *algod.Client
+}
+
+var (
+	_ types.DryrunAPI = (*algodClient)(nil)
+	_ types.StatusAPI = (*algodClient)(nil)
+	_ types.BlockAPI  = (*algodClient)(nil)
+)
+
+// NewAlgodAPI wraps an algod client so that it can be used as an AlgodAPI, the result implements DryrunAPI, StatusAPI and BlockAPI as well
+func NewAlgodAPI(ac *algod.Client) types.AlgodAPI {
+	return &algodClient{ac: ac}
+}
+
+// AccountInformation returns account information of a given address
+func (c *algodClient) AccountInformation(ctx context.Context, address string) (models.Account, error) {
+	return c.ac.AccountInformation(address).Do(ctx)
+}
+
+// GetAssetByID returns asset information of a given asset id
+func (c *algodClient) GetAssetByID(ctx context.Context, assetID uint64) (models.Asset, error) {
+	return c.ac.GetAssetByID(assetID).Do(ctx)
+}
+
+// SuggestedParams returns the suggested parameters for constructing a new transaction
+func (c *algodClient) SuggestedParams(ctx context.Context) (algoTypes.SuggestedParams, error) {
+	return c.ac.SuggestedParams().Do(ctx)
+}
+
+// SendRawTransaction submits encoded signed transactions and returns the id of the first transaction
+func (c *algodClient) SendRawTransaction(ctx context.Context, rawTxn []byte) (string, error) {
+	return c.ac.SendRawTransaction(rawTxn).Do(ctx)
+}
+
+// PendingTransactionInformation returns information of a pending or recently confirmed transaction
+func (c *algodClient) PendingTransactionInformation(ctx context.Context, txID string) (models.PendingTransactionInfoResponse, error) {
+	info, _, err := c.ac.PendingTransactionInformation(txID).Do(ctx)
+
+	return info, err
+}
+
+// WaitForConfirmation waits for a pending transaction to be confirmed within waitRounds rounds.
+// It checks the transaction once per round with StatusAfterBlock when the node implements StatusAPI,
+// and polls every WaitInterval otherwise. Only not found errors are ignored, since algod behind a load balancer
+// may not know a transaction which was sent to another instance yet.
+func WaitForConfirmation(ctx context.Context, ac types.AlgodAPI, txID string, waitRounds int) (*models.PendingTransactionInfoResponse, error) {
+	status, hasStatus := ac.(types.StatusAPI)
+	var round uint64
+	if hasStatus {
+		nodeStatus, err := status.Status(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		round = nodeStatus.LastRound
+	}
+
+	for attempt := 0; attempt < waitRounds; attempt++ {
+		info, err := ac.PendingTransactionInformation(ctx, txID)
+		if err != nil && !isNotFound(err) {
+			return nil, err
+		}
+		if err == nil {
+			if len(info.PoolError) != 0 {
+				return nil, DecodeRejection(fmt.Errorf("transaction %s rejected: %s", txID, info.PoolError), nil)
+			}
+
+			if info.ConfirmedRound > 0 {
+				return &info, nil
+			}
+		}
+
+		if hasStatus {
+			nodeStatus, err := status.StatusAfterBlock(ctx, round)
+			if err != nil {
+				return nil, err
+			}
+
+			round = nodeStatus.LastRound
+
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(constants.WaitInterval):
+		}
+	}
+
+	return nil, fmt.Errorf("wait for transaction id %s timed out", txID)
+}
+
+// isNotFound reports whether an algod error is a 404 response
+func isNotFound(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), "HTTP 404")
+}
+
+// GetApplicationByID returns application information of a given application id
+func (c *algodClient) GetApplicationByID(ctx context.Context, appID uint64) (models.Application, error) {
+	return c.ac.GetApplicationByID(appID).Do(ctx)
+}
+
+// TealDryrun executes transactions against a given ledger state without committing them
+func (c *algodClient) TealDryrun(ctx context.Context, request models.DryrunRequest) (models.DryrunResponse, error) {
+	return c.ac.TealDryrun(request).Do(ctx)
+}
+
+// Status returns the current status of the node
+func (c *algodClient) Status(ctx context.Context) (models.NodeStatus, error) {
+	return c.ac.Status().Do(ctx)
+}
+
+// StatusAfterBlock waits for a block after a given round and returns the status of the node
+func (c *algodClient) StatusAfterBlock(ctx context.Context, round uint64) (models.NodeStatus, error) {
+	return c.ac.StatusAfterBlock(round).Do(ctx)
+}
+
+// Block returns a confirmed block of a given round
+func (c *algodClient) Block(ctx context.Context, round uint64) (algoTypes.Block, error) {
+	return c.ac.Block(round).Do(ctx)
+}
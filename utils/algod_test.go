@@ -0,0 +1,52 @@
+package utils_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/algodtest"
+)
+
+// flakyNode answers pending transaction information with given errors, moves to the next round on every call
+// and reports the transaction as confirmed once the errors run out
+type flakyNode struct {
+	*algodtest.Node
+	errs  []error
+	calls int
+}
+
+func (n *flakyNode) PendingTransactionInformation(ctx context.Context, txID string) (models.PendingTransactionInfoResponse, error) {
+	n.calls++
+	n.SetRound(n.Round() + 1)
+	if n.calls <= len(n.errs) {
+		return models.PendingTransactionInfoResponse{}, n.errs[n.calls-1]
+	}
+
+	return models.PendingTransactionInfoResponse{ConfirmedRound: n.Round()}, nil
+}
+
+func TestWaitForConfirmation(t *testing.T) {
+	notFound := fmt.Errorf("HTTP 404: transaction is not found")
+	node := &flakyNode{Node: algodtest.NewNode(), errs: []error{notFound, notFound}}
+	info, err := utils.WaitForConfirmation(context.Background(), node, "tx", 3)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if node.calls != 3 || info.ConfirmedRound != node.Round() {
+		t.Errorf("WaitForConfirmation returned after %d calls at round %d", node.calls, info.ConfirmedRound)
+	}
+
+	node = &flakyNode{Node: algodtest.NewNode(), errs: []error{notFound, notFound, notFound}}
+	if _, err := utils.WaitForConfirmation(context.Background(), node, "tx", 3); err == nil || node.calls != 3 {
+		t.Errorf("WaitForConfirmation did not time out after 3 rounds")
+	}
+
+	node = &flakyNode{Node: algodtest.NewNode(), errs: []error{fmt.Errorf("HTTP 500: internal error")}}
+	if _, err := utils.WaitForConfirmation(context.Background(), node, "tx", 3); err == nil || node.calls != 1 {
+		t.Errorf("WaitForConfirmation ignored an error which is not 404")
+	}
+}
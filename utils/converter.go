@@ -3,6 +3,7 @@ package utils
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
 	"math/big"
 )
 
@@ -16,6 +17,15 @@ func IntToBytes(n uint64) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// BytesToInt converts 8-bit bytes written by IntToBytes into int
+func BytesToInt(b []byte) (uint64, error) {
+	if len(b) != 8 {
+		return 0, fmt.Errorf("expected 8 bytes, got %d", len(b))
+	}
+
+	return binary.BigEndian.Uint64(b), nil
+}
+
 // ToBigUint converts an unsigned 64-bit integer to big integer
 func ToBigUint(v uint64) *big.Int {
 	return new(big.Int).SetUint64(v)
@@ -0,0 +1,221 @@
+package utils
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/types"
+
+	tTypes "github.com/synycboom/tinyman-go-sdk/types"
+)
+
+// Dryrun dry-runs the transaction group against the node and returns a report.
+// Unsigned transactions are dry-run as they are since the dryrun endpoint does not verify signatures.
+func (tg *TransactionGroup) Dryrun(ctx context.Context, client tTypes.AlgodAPI) (*tTypes.DryrunReport, error) {
+	dc, ok := client.(tTypes.DryrunAPI)
+	if !ok {
+		return nil, fmt.Errorf("algod client does not support dryrun")
+	}
+
+	request, err := tg.dryrunRequest(ctx, client, dc)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := dc.TealDryrun(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return tg.dryrunReport(response)
+}
+
+// dryrunRequest builds a dryrun request with the state of every account and app the group touches
+func (tg *TransactionGroup) dryrunRequest(ctx context.Context, client tTypes.AlgodAPI, dc tTypes.DryrunAPI) (models.DryrunRequest, error) {
+	var request models.DryrunRequest
+	var addresses []types.Address
+	var appIDs []uint64
+	for idx, tx := range tg.transactions {
+		stx := types.SignedTxn{Txn: tx}
+		if len(tg.signedTransactions[idx]) > 0 {
+			if err := msgpack.Decode(tg.signedTransactions[idx], &stx); err != nil {
+				return request, err
+			}
+		}
+		request.Txns = append(request.Txns, stx)
+
+		addresses = append(addresses, tx.Sender, tx.Receiver, tx.AssetReceiver)
+		if tx.Type == types.ApplicationCallTx {
+			appIDs = append(appIDs, uint64(tx.ApplicationID))
+			addresses = append(addresses, tx.Accounts...)
+			addresses = append(addresses, crypto.GetApplicationAddress(uint64(tx.ApplicationID)))
+			for _, appID := range tx.ForeignApps {
+				appIDs = append(appIDs, uint64(appID))
+			}
+			for _, assetID := range tx.ForeignAssets {
+				creator, err := assetCreator(ctx, client, uint64(assetID))
+				if err != nil {
+					return request, err
+				}
+
+				addresses = append(addresses, creator)
+			}
+		}
+	}
+
+	seenAddresses := make(map[types.Address]bool)
+	for _, address := range addresses {
+		if address.IsZero() || seenAddresses[address] {
+			continue
+		}
+		seenAddresses[address] = true
+
+		account, err := client.AccountInformation(ctx, address.String())
+		if err != nil {
+			return request, err
+		}
+
+		request.Accounts = append(request.Accounts, account)
+	}
+
+	seenApps := make(map[uint64]bool)
+	for _, appID := range appIDs {
+		if appID == 0 || seenApps[appID] {
+			continue
+		}
+		seenApps[appID] = true
+
+		app, err := dc.GetApplicationByID(ctx, appID)
+		if err != nil {
+			return request, err
+		}
+
+		request.Apps = append(request.Apps, app)
+	}
+
+	return request, nil
+}
+
+// dryrunReport converts a dryrun response to a report
+func (tg *TransactionGroup) dryrunReport(response models.DryrunResponse) (*tTypes.DryrunReport, error) {
+	report := &tTypes.DryrunReport{
+		Passed: len(response.Error) == 0,
+		Error:  response.Error,
+	}
+	if len(response.Txns) != len(tg.transactions) && len(response.Error) == 0 {
+		return nil, fmt.Errorf("dryrun returned %d results for %d transactions", len(response.Txns), len(tg.transactions))
+	}
+
+	for idx, result := range response.Txns {
+		tx := tg.transactions[idx]
+		txReport := tTypes.DryrunTransaction{
+			TxID:             crypto.GetTxID(tx),
+			GroupIndex:       idx,
+			Passed:           !rejected(result.AppCallMessages) && !rejected(result.LogicSigMessages),
+			AppCallMessages:  result.AppCallMessages,
+			LogicSigMessages: result.LogicSigMessages,
+			Logs:             result.Logs,
+			Cost:             result.Cost,
+		}
+
+		globalDelta, err := stateDeltas(result.GlobalDelta)
+		if err != nil {
+			return nil, err
+		}
+		txReport.GlobalDelta = globalDelta
+
+		for _, localDelta := range result.LocalDeltas {
+			deltas, err := stateDeltas(localDelta.Delta)
+			if err != nil {
+				return nil, err
+			}
+
+			txReport.LocalDeltas = append(txReport.LocalDeltas, tTypes.LocalStateDelta{
+				Address: localDelta.Address,
+				Deltas:  deltas,
+			})
+		}
+
+		report.Passed = report.Passed && txReport.Passed
+		report.Transactions = append(report.Transactions, txReport)
+	}
+
+	for _, tx := range tg.transactions {
+		switch tx.Type {
+		case types.PaymentTx:
+			report.Transfers = append(report.Transfers, tTypes.Transfer{
+				Sender:   tx.Sender.String(),
+				Receiver: tx.Receiver.String(),
+				Amount:   uint64(tx.Amount),
+			})
+		case types.AssetTransferTx:
+			if tx.AssetAmount == 0 {
+				continue
+			}
+
+			report.Transfers = append(report.Transfers, tTypes.Transfer{
+				Sender:   tx.Sender.String(),
+				Receiver: tx.AssetReceiver.String(),
+				AssetID:  uint64(tx.XferAsset),
+				Amount:   tx.AssetAmount,
+			})
+		}
+	}
+
+	return report, nil
+}
+
+// assetCreator returns a creator of an asset, Algo has no creator
+func assetCreator(ctx context.Context, client tTypes.AlgodAPI, assetID uint64) (types.Address, error) {
+	if assetID == 0 {
+		return types.Address{}, nil
+	}
+
+	asset, err := client.GetAssetByID(ctx, assetID)
+	if err != nil {
+		return types.Address{}, err
+	}
+	if len(asset.Params.Creator) == 0 {
+		return types.Address{}, nil
+	}
+
+	return types.DecodeAddress(asset.Params.Creator)
+}
+
+func rejected(messages []string) bool {
+	for _, message := range messages {
+		if message == "REJECT" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func stateDeltas(kvs []models.EvalDeltaKeyValue) ([]tTypes.StateDelta, error) {
+	var deltas []tTypes.StateDelta
+	for _, kv := range kvs {
+		key, err := base64.StdEncoding.DecodeString(kv.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := base64.StdEncoding.DecodeString(kv.Value.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		deltas = append(deltas, tTypes.StateDelta{
+			Key:    key,
+			Action: kv.Value.Action,
+			Bytes:  value,
+			Uint:   kv.Value.Uint,
+		})
+	}
+
+	return deltas, nil
+}
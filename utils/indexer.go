@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/client/v2/indexer"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+)
+
+// indexerClient adapts an indexer client to the IndexerAPI interface
+type indexerClient struct {
+	ic *indexer.Client
+}
+
+// NewIndexerAPI wraps an indexer client so that it can be used as an IndexerAPI
+func NewIndexerAPI(ic *indexer.Client) types.IndexerAPI {
+	return &indexerClient{ic: ic}
+}
+
+// SearchAccountsByApp returns a page of accounts opted into a given application
+func (c *indexerClient) SearchAccountsByApp(ctx context.Context, appID, limit uint64, next string) (models.AccountsResponse, error) {
+	req := c.ic.SearchAccounts().ApplicationId(appID)
+	if limit > 0 {
+		req = req.Limit(limit)
+	}
+	if len(next) > 0 {
+		req = req.NextToken(next)
+	}
+
+	return req.Do(ctx)
+}
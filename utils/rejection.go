@@ -0,0 +1,120 @@
+package utils
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/types"
+
+	tTypes "github.com/synycboom/tinyman-go-sdk/types"
+)
+
+var (
+	rejectionTxIDPattern = regexp.MustCompile(`transaction ([A-Z2-7]{52})(?::\s*)?`)
+	rejectionPCPattern   = regexp.MustCompile(`pc=(\d+)`)
+	rejectionAppPattern  = regexp.MustCompile(`app=(\d+)`)
+
+	// rejectionMessages maps parts of algod rejection messages of the ledger to SDK errors, the first match wins,
+	// failed logic evaluations are mapped by program counters registered with RegisterRejectionPC
+	rejectionMessages = []struct {
+		message string
+		err     error
+	}{
+		{"has not opted in to", tTypes.ErrNotOptedIn},
+		{"missing from", tTypes.ErrNotOptedIn},
+		{"must optin", tTypes.ErrNotOptedIn},
+		{"overspend", tTypes.ErrInsufficientBalance},
+		{"underflow on subtracting", tTypes.ErrInsufficientBalance},
+		{"below min", tTypes.ErrInsufficientBalance},
+	}
+
+	rejectionPCsLock sync.RWMutex
+	rejectionPCs     = map[uint64]map[uint64]error{}
+)
+
+// RegisterRejectionPC maps a failed logic evaluation at a program counter of a validator app to an SDK error
+func RegisterRejectionPC(validatorAppID, pc uint64, err error) {
+	rejectionPCsLock.Lock()
+	defer rejectionPCsLock.Unlock()
+
+	if rejectionPCs[validatorAppID] == nil {
+		rejectionPCs[validatorAppID] = map[uint64]error{}
+	}
+	rejectionPCs[validatorAppID][pc] = err
+}
+
+// UnregisterRejectionPCs removes program counters registered for a validator app
+func UnregisterRejectionPCs(validatorAppID uint64) {
+	rejectionPCsLock.Lock()
+	defer rejectionPCsLock.Unlock()
+
+	delete(rejectionPCs, validatorAppID)
+}
+
+// DecodeRejection converts an error of algod rejecting a transaction group to *types.RejectedError which maps to SDK errors,
+// txs are the submitted transactions used to find the rejected transaction, other errors are returned as they are
+func DecodeRejection(err error, txs []types.Transaction) error {
+	if err == nil {
+		return nil
+	}
+
+	var rejected *tTypes.RejectedError
+	if errors.As(err, &rejected) {
+		return err
+	}
+
+	message := err.Error()
+	rejected = &tTypes.RejectedError{
+		GroupIndex: -1,
+		Reason:     message,
+		Cause:      err,
+	}
+	if match := rejectionTxIDPattern.FindStringSubmatchIndex(message); match != nil {
+		rejected.TxID = message[match[2]:match[3]]
+		rejected.Reason = message[match[1]:]
+		for idx, tx := range txs {
+			if crypto.GetTxID(tx) == rejected.TxID {
+				rejected.GroupIndex = idx
+				rejected.AppID = uint64(tx.ApplicationID)
+			}
+		}
+	}
+	if match := rejectionAppPattern.FindStringSubmatch(message); match != nil {
+		rejected.AppID, _ = strconv.ParseUint(match[1], 10, 64)
+	}
+	if match := rejectionPCPattern.FindStringSubmatch(message); match != nil {
+		rejected.PC, _ = strconv.ParseUint(match[1], 10, 64)
+	}
+
+	rejected.Err = rejectionError(rejected)
+	if rejected.TxID == "" && rejected.Err == nil {
+		return err
+	}
+
+	return rejected
+}
+
+// rejectionError returns an SDK error which a rejection maps to, registered program counters take precedence over messages
+func rejectionError(rejected *tTypes.RejectedError) error {
+	if rejected.PC > 0 {
+		rejectionPCsLock.RLock()
+		err := rejectionPCs[rejected.AppID][rejected.PC]
+		rejectionPCsLock.RUnlock()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, rm := range rejectionMessages {
+		if strings.Contains(rejected.Reason, rm.message) {
+			return rm.err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package utils_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/future"
+	algoTypes "github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+)
+
+func TestDecodeRejection(t *testing.T) {
+	user := crypto.GenerateAccount()
+	sp := algoTypes.SuggestedParams{FirstRoundValid: 1, LastRoundValid: 1000, MinFee: 1000, GenesisHash: make([]byte, 32)}
+	payment, err := future.MakePaymentTxn(user.Address.String(), user.Address.String(), 1000, nil, "", sp)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	appCall, err := future.MakeApplicationNoOpTx(62368684, [][]byte{[]byte("swap")}, nil, nil, nil, sp, user.Address, nil, algoTypes.Digest{}, [32]byte{}, algoTypes.Address{})
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	txs := []algoTypes.Transaction{payment, appCall}
+	appCallID := crypto.GetTxID(appCall)
+	utils.RegisterRejectionPC(62368684, 1070, types.ErrSlippageExceeded)
+	utils.RegisterRejectionPC(62368684, 1065, types.ErrInsufficientLiquidity)
+
+	cases := []struct {
+		message    string
+		expected   error
+		groupIndex int
+		pc         uint64
+	}{
+		{
+			message:    fmt.Sprintf("TransactionPool.Remember: transaction %s: logic eval error: - would result negative. Details: pc=1070, opcodes=load 20\nload 53\n-\n", appCallID),
+			expected:   types.ErrSlippageExceeded,
+			groupIndex: 1,
+			pc:         1070,
+		},
+		{
+			message:    fmt.Sprintf("TransactionPool.Remember: transaction %s: asset 31566704 missing from %s", crypto.GetTxID(payment), user.Address.String()),
+			expected:   types.ErrNotOptedIn,
+			groupIndex: 0,
+		},
+		{
+			message:    fmt.Sprintf("TransactionPool.Remember: transaction %s: underflow on subtracting 1000 from sender amount 10", crypto.GetTxID(payment)),
+			expected:   types.ErrInsufficientBalance,
+			groupIndex: 0,
+		},
+		{
+			message:    fmt.Sprintf("TransactionPool.Remember: transaction %s: logic eval error: assert failed pc=1065", appCallID),
+			expected:   types.ErrInsufficientLiquidity,
+			groupIndex: 1,
+			pc:         1065,
+		},
+	}
+	for _, c := range cases {
+		err := utils.DecodeRejection(errors.New(c.message), txs)
+		if !errors.Is(err, c.expected) {
+			t.Errorf("DecodeRejection returned wrong error for %s", c.message)
+		}
+
+		var rejected *types.RejectedError
+		if !errors.As(err, &rejected) {
+			t.Fatalf("DecodeRejection should return a rejected error, got %v", err)
+		}
+		if rejected.GroupIndex != c.groupIndex || rejected.PC != c.pc || rejected.Error() != c.message {
+			t.Errorf("DecodeRejection returned wrong rejection %+v", rejected)
+		}
+	}
+
+	other := errors.New("connection refused")
+	if err := utils.DecodeRejection(other, txs); err != other {
+		t.Errorf("DecodeRejection should return other errors as they are, got %v", err)
+	}
+}
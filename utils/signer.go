@@ -0,0 +1,258 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/client/kmd"
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/types"
+	"golang.org/x/crypto/ed25519"
+)
+
+// Signer signs the transactions it owns in a transaction group
+type Signer interface {
+	// SignTransactions returns encoded signed transactions keyed by their indexes in the group.
+	// A signer owns the transactions whose auth address it holds the key of, authAddrs[idx] is the sender of txs[idx] unless it is rekeyed.
+	SignTransactions(ctx context.Context, txs []types.Transaction, authAddrs []types.Address) (map[int][]byte, error)
+}
+
+// AccountSigner signs transactions with in-memory accounts
+type AccountSigner struct {
+	accounts []crypto.Account
+}
+
+// NewAccountSigner creates a signer of in-memory accounts
+func NewAccountSigner(accounts ...crypto.Account) *AccountSigner {
+	return &AccountSigner{accounts: accounts}
+}
+
+// SignTransactions signs transactions authorized by the accounts
+func (s *AccountSigner) SignTransactions(ctx context.Context, txs []types.Transaction, authAddrs []types.Address) (map[int][]byte, error) {
+	signed := make(map[int][]byte)
+	for idx, tx := range txs {
+		for _, acc := range s.accounts {
+			if authAddrs[idx] != acc.Address {
+				continue
+			}
+
+			_, stx, err := crypto.SignTransaction(acc.PrivateKey, tx)
+			if err != nil {
+				return nil, err
+			}
+
+			signed[idx] = stx
+			break
+		}
+	}
+
+	return signed, nil
+}
+
+// LogicSigSigner signs transactions with a logic signature account, e.g. a pool
+type LogicSigSigner struct {
+	account *crypto.LogicSigAccount
+}
+
+// NewLogicSigSigner creates a signer of a logic signature account
+func NewLogicSigSigner(account *crypto.LogicSigAccount) *LogicSigSigner {
+	return &LogicSigSigner{account: account}
+}
+
+// SignTransactions signs transactions authorized by the logic signature account
+func (s *LogicSigSigner) SignTransactions(ctx context.Context, txs []types.Transaction, authAddrs []types.Address) (map[int][]byte, error) {
+	address, err := s.account.Address()
+	if err != nil {
+		return nil, err
+	}
+
+	signed := make(map[int][]byte)
+	for idx, tx := range txs {
+		if authAddrs[idx] != address {
+			continue
+		}
+
+		_, stx, err := crypto.SignLogicsigTransaction(s.account.Lsig, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		signed[idx] = stx
+	}
+
+	return signed, nil
+}
+
+// MultisigSigner signs transactions of a multisig account with some of its keys.
+// Partial signatures of several signers are merged by TransactionGroup.SignWith.
+type MultisigSigner struct {
+	account crypto.MultisigAccount
+	keys    []ed25519.PrivateKey
+}
+
+// NewMultisigSigner creates a signer of a multisig account with the given member keys
+func NewMultisigSigner(account crypto.MultisigAccount, keys ...ed25519.PrivateKey) *MultisigSigner {
+	return &MultisigSigner{
+		account: account,
+		keys:    keys,
+	}
+}
+
+// SignTransactions signs transactions authorized by the multisig account with every key of the signer
+func (s *MultisigSigner) SignTransactions(ctx context.Context, txs []types.Transaction, authAddrs []types.Address) (map[int][]byte, error) {
+	address, err := s.account.Address()
+	if err != nil {
+		return nil, err
+	}
+
+	signed := make(map[int][]byte)
+	for idx, tx := range txs {
+		if authAddrs[idx] != address {
+			continue
+		}
+
+		var partials [][]byte
+		for _, key := range s.keys {
+			_, stx, err := crypto.SignMultisigTransaction(key, s.account, tx)
+			if err != nil {
+				return nil, err
+			}
+
+			partials = append(partials, stx)
+		}
+		if len(partials) == 0 {
+			continue
+		}
+
+		stx := partials[0]
+		if len(partials) > 1 {
+			_, merged, err := crypto.MergeMultisigTransactions(partials...)
+			if err != nil {
+				return nil, err
+			}
+
+			stx = merged
+		}
+
+		signed[idx] = stx
+	}
+
+	return signed, nil
+}
+
+// KMDClient is the subset of the kmd client used by KMDSigner, kmd.Client implements it
+type KMDClient interface {
+	InitWalletHandle(walletID, walletPassword string) (kmd.InitWalletHandleResponse, error)
+	ReleaseWalletHandle(walletHandle string) (kmd.ReleaseWalletHandleResponse, error)
+	ListKeys(walletHandle string) (kmd.ListKeysResponse, error)
+	SignTransaction(walletHandle, walletPassword string, tx types.Transaction) (kmd.SignTransactionResponse, error)
+	SignTransactionWithSpecificPublicKey(walletHandle, walletPassword string, tx types.Transaction, pk ed25519.PublicKey) (kmd.SignTransactionResponse, error)
+}
+
+var _ KMDClient = kmd.Client{}
+
+// KMDSigner signs transactions with keys of a KMD wallet
+type KMDSigner struct {
+	client         KMDClient
+	walletID       string
+	walletPassword string
+}
+
+// NewKMDSigner creates a signer of a KMD wallet
+func NewKMDSigner(client KMDClient, walletID, walletPassword string) *KMDSigner {
+	return &KMDSigner{
+		client:         client,
+		walletID:       walletID,
+		walletPassword: walletPassword,
+	}
+}
+
+// SignTransactions signs transactions authorized by addresses of the wallet, a rekeyed transaction is signed with the key of its auth address
+func (s *KMDSigner) SignTransactions(ctx context.Context, txs []types.Transaction, authAddrs []types.Address) (map[int][]byte, error) {
+	handle, err := s.client.InitWalletHandle(s.walletID, s.walletPassword)
+	if err != nil {
+		return nil, err
+	}
+	defer s.client.ReleaseWalletHandle(handle.WalletHandleToken)
+
+	keys, err := s.client.ListKeys(handle.WalletHandleToken)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make(map[string]bool)
+	for _, address := range keys.Addresses {
+		addresses[address] = true
+	}
+
+	signed := make(map[int][]byte)
+	for idx, tx := range txs {
+		authAddr := authAddrs[idx]
+		if !addresses[authAddr.String()] {
+			continue
+		}
+
+		var res kmd.SignTransactionResponse
+		if authAddr == tx.Sender {
+			res, err = s.client.SignTransaction(handle.WalletHandleToken, s.walletPassword, tx)
+		} else {
+			res, err = s.client.SignTransactionWithSpecificPublicKey(handle.WalletHandleToken, s.walletPassword, tx, authAddr[:])
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		signed[idx] = res.SignedTransaction
+	}
+
+	return signed, nil
+}
+
+// SignFunc signs a message with a key held elsewhere, e.g. by a remote service or an HSM, and returns an ed25519 signature
+type SignFunc func(ctx context.Context, publicKey ed25519.PublicKey, message []byte) ([]byte, error)
+
+// RemoteSigner signs transactions of an address by a callback which holds its key
+type RemoteSigner struct {
+	publicKey ed25519.PublicKey
+	sign      SignFunc
+}
+
+// NewRemoteSigner creates a signer of an account whose key is only reachable through a callback
+func NewRemoteSigner(publicKey ed25519.PublicKey, sign SignFunc) *RemoteSigner {
+	return &RemoteSigner{
+		publicKey: publicKey,
+		sign:      sign,
+	}
+}
+
+// SignTransactions signs transactions authorized by the address of the public key, the callback gets "TX" followed by a transaction
+func (s *RemoteSigner) SignTransactions(ctx context.Context, txs []types.Transaction, authAddrs []types.Address) (map[int][]byte, error) {
+	var address types.Address
+	copy(address[:], s.publicKey)
+
+	signed := make(map[int][]byte)
+	for idx, tx := range txs {
+		if authAddrs[idx] != address {
+			continue
+		}
+
+		message := append([]byte("TX"), msgpack.Encode(tx)...)
+		sig, err := s.sign(ctx, s.publicKey, message)
+		if err != nil {
+			return nil, err
+		}
+		if !ed25519.Verify(s.publicKey, message, sig) {
+			return nil, fmt.Errorf("remote signer returned an invalid signature for transaction %d", idx)
+		}
+
+		stx := types.SignedTxn{Txn: tx}
+		copy(stx.Sig[:], sig)
+		if tx.Sender != address {
+			stx.AuthAddr = address
+		}
+		signed[idx] = msgpack.Encode(stx)
+	}
+
+	return signed, nil
+}
@@ -0,0 +1,233 @@
+package utils_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/client/kmd"
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/future"
+	"github.com/algorand/go-algorand-sdk/types"
+	"golang.org/x/crypto/ed25519"
+
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+	"github.com/synycboom/tinyman-go-sdk/v1/simulator"
+)
+
+type fakeKMD struct {
+	accounts []crypto.Account
+}
+
+func (k *fakeKMD) InitWalletHandle(walletID, walletPassword string) (kmd.InitWalletHandleResponse, error) {
+	if walletPassword != "password" {
+		return kmd.InitWalletHandleResponse{}, fmt.Errorf("wrong password")
+	}
+
+	return kmd.InitWalletHandleResponse{WalletHandleToken: "handle"}, nil
+}
+
+func (k *fakeKMD) ReleaseWalletHandle(walletHandle string) (kmd.ReleaseWalletHandleResponse, error) {
+	return kmd.ReleaseWalletHandleResponse{}, nil
+}
+
+func (k *fakeKMD) ListKeys(walletHandle string) (kmd.ListKeysResponse, error) {
+	var res kmd.ListKeysResponse
+	for _, acc := range k.accounts {
+		res.Addresses = append(res.Addresses, acc.Address.String())
+	}
+
+	return res, nil
+}
+
+func (k *fakeKMD) SignTransaction(walletHandle, walletPassword string, tx types.Transaction) (kmd.SignTransactionResponse, error) {
+	for _, acc := range k.accounts {
+		if acc.Address == tx.Sender {
+			_, stx, err := crypto.SignTransaction(acc.PrivateKey, tx)
+
+			return kmd.SignTransactionResponse{SignedTransaction: stx}, err
+		}
+	}
+
+	return kmd.SignTransactionResponse{}, fmt.Errorf("key does not exist in this wallet")
+}
+
+func (k *fakeKMD) SignTransactionWithSpecificPublicKey(walletHandle, walletPassword string, tx types.Transaction, pk ed25519.PublicKey) (kmd.SignTransactionResponse, error) {
+	for _, acc := range k.accounts {
+		if acc.PublicKey.Equal(pk) {
+			_, stx, err := crypto.SignTransaction(acc.PrivateKey, tx)
+
+			return kmd.SignTransactionResponse{SignedTransaction: stx}, err
+		}
+	}
+
+	return kmd.SignTransactionResponse{}, fmt.Errorf("key does not exist in this wallet")
+}
+
+func TestSignWith(t *testing.T) {
+	ctx := context.Background()
+	local := crypto.GenerateAccount()
+	wallet := crypto.GenerateAccount()
+	remote := crypto.GenerateAccount()
+	member1 := crypto.GenerateAccount()
+	member2 := crypto.GenerateAccount()
+	multisig, err := crypto.MultisigAccountWithParams(1, 2, []types.Address{member1.Address, member2.Address})
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	multisigAddress, err := multisig.Address()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	sim := simulator.New(constants.TestnetValidatorAppId)
+	senders := []types.Address{local.Address, wallet.Address, remote.Address, multisigAddress}
+	var txs []types.Transaction
+	for _, sender := range senders {
+		if err := sim.SetBalance(sender.String(), 0, 1000000); err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		sp, err := sim.SuggestedParams(ctx)
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+		tx, err := future.MakePaymentTxn(sender.String(), local.Address.String(), 1000, nil, "", sp)
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		txs = append(txs, tx)
+	}
+
+	txGroup, err := utils.NewTransactionGroup(txs)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if !reflect.DeepEqual(txGroup.UnsignedIndexes(), []int{0, 1, 2, 3}) {
+		t.Fatalf("UnsignedIndexes returned wrong indexes %v", txGroup.UnsignedIndexes())
+	}
+
+	remoteSigner := utils.NewRemoteSigner(remote.PublicKey, func(ctx context.Context, pk ed25519.PublicKey, message []byte) ([]byte, error) {
+		return ed25519.Sign(remote.PrivateKey, message), nil
+	})
+	err = txGroup.SignWith(
+		ctx,
+		utils.NewAccountSigner(local),
+		utils.NewKMDSigner(&fakeKMD{accounts: []crypto.Account{wallet}}, "wallet", "password"),
+		remoteSigner,
+		utils.NewMultisigSigner(multisig, member1.PrivateKey),
+	)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	// the multisig transaction has one of two signatures
+	if !reflect.DeepEqual(txGroup.UnsignedIndexes(), []int{3}) {
+		t.Fatalf("UnsignedIndexes returned wrong indexes %v", txGroup.UnsignedIndexes())
+	}
+	if _, err := txGroup.Submit(ctx, sim, false); err == nil {
+		t.Errorf("Submit should return an error when the group is not fully signed")
+	}
+
+	if err := txGroup.SignWith(ctx, utils.NewMultisigSigner(multisig, member2.PrivateKey)); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(txGroup.UnsignedIndexes()) != 0 {
+		t.Fatalf("UnsignedIndexes returned wrong indexes %v", txGroup.UnsignedIndexes())
+	}
+	if err := sim.Execute(txGroup); err != nil {
+		t.Errorf("Unexpected err %s", err.Error())
+	}
+}
+
+func TestRemoteSignerRejectsInvalidSignature(t *testing.T) {
+	remote := crypto.GenerateAccount()
+	other := crypto.GenerateAccount()
+	sp := types.SuggestedParams{FirstRoundValid: 1, LastRoundValid: 1000, MinFee: 1000, GenesisHash: make([]byte, 32)}
+	tx, err := future.MakePaymentTxn(remote.Address.String(), other.Address.String(), 1000, nil, "", sp)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	signer := utils.NewRemoteSigner(remote.PublicKey, func(ctx context.Context, pk ed25519.PublicKey, message []byte) ([]byte, error) {
+		return ed25519.Sign(other.PrivateKey, message), nil
+	})
+	if _, err := signer.SignTransactions(context.Background(), []types.Transaction{tx}, []types.Address{tx.Sender}); err == nil {
+		t.Errorf("SignTransactions should reject a signature of another key")
+	}
+}
+
+func TestSignWithRekeyedAccounts(t *testing.T) {
+	ctx := context.Background()
+	rekeyed := crypto.GenerateAccount()
+	auth := crypto.GenerateAccount()
+	walletRekeyed := crypto.GenerateAccount()
+	walletAuth := crypto.GenerateAccount()
+
+	sim := simulator.New(constants.TestnetValidatorAppId)
+	sp, err := sim.SuggestedParams(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	var txs []types.Transaction
+	for _, sender := range []types.Address{rekeyed.Address, walletRekeyed.Address} {
+		if err := sim.SetBalance(sender.String(), 0, 1000000); err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		tx, err := future.MakePaymentTxn(sender.String(), rekeyed.Address.String(), 1000, nil, "", sp)
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		txs = append(txs, tx)
+	}
+
+	txGroup, err := utils.NewTransactionGroup(txs)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if err := txGroup.SetAuthAddr(rekeyed.Address.String(), auth.Address.String()); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	// a wallet learns the auth address from the sign request and a decoded request keeps it
+	wtxns, err := txGroup.WalletTransactions()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	wtxns[1].AuthAddr = walletAuth.Address.String()
+	if wtxns[0].AuthAddr != auth.Address.String() {
+		t.Fatalf("WalletTransactions returned wrong auth address %s", wtxns[0].AuthAddr)
+	}
+	txGroup, err = utils.DecodeWalletTransactions(wtxns)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if !reflect.DeepEqual(txGroup.AuthAddrs(), []types.Address{auth.Address, walletAuth.Address}) {
+		t.Fatalf("AuthAddrs returned wrong addresses %v", txGroup.AuthAddrs())
+	}
+
+	// keys of the senders do not sign rekeyed transactions
+	err = txGroup.SignWith(ctx, utils.NewAccountSigner(rekeyed), utils.NewKMDSigner(&fakeKMD{accounts: []crypto.Account{walletRekeyed}}, "wallet", "password"))
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(txGroup.UnsignedIndexes()) != 2 {
+		t.Fatalf("UnsignedIndexes returned wrong indexes %v", txGroup.UnsignedIndexes())
+	}
+
+	err = txGroup.SignWith(ctx, utils.NewAccountSigner(auth), utils.NewKMDSigner(&fakeKMD{accounts: []crypto.Account{walletAuth}}, "wallet", "password"))
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(txGroup.UnsignedIndexes()) != 0 {
+		t.Fatalf("UnsignedIndexes returned wrong indexes %v", txGroup.UnsignedIndexes())
+	}
+	if err := sim.Execute(txGroup); err != nil {
+		t.Errorf("Unexpected err %s", err.Error())
+	}
+}
@@ -56,7 +56,7 @@ func ExcessAssetStateKey(poolAddress string, assetID uint64) ([]byte, error) {
 	}
 
 	var key []byte
-	key = append(key, []byte(addr.String())...)
+	key = append(key, addr[:]...)
 	key = append(key, []byte("e")[0])
 	key = append(key, assetIDInBytes...)
 
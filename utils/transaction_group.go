@@ -5,11 +5,11 @@ import (
 	"fmt"
 	"golang.org/x/crypto/ed25519"
 
-	"github.com/algorand/go-algorand-sdk/client/v2/algod"
 	"github.com/algorand/go-algorand-sdk/crypto"
-	"github.com/algorand/go-algorand-sdk/future"
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
 	"github.com/algorand/go-algorand-sdk/types"
 
+	tTypes "github.com/synycboom/tinyman-go-sdk/types"
 	"github.com/synycboom/tinyman-go-sdk/v1/constants"
 )
 
@@ -17,6 +17,9 @@ import (
 type TransactionGroup struct {
 	transactions       []types.Transaction
 	signedTransactions [][]byte
+
+	// authAddrs are the addresses which sender accounts are rekeyed to, a zero address means the sender signs
+	authAddrs []types.Address
 }
 
 // NewTransactionGroup creates a new transaction group
@@ -35,14 +38,52 @@ func NewTransactionGroup(txs []types.Transaction) (*TransactionGroup, error) {
 	return &TransactionGroup{
 		transactions:       txsWithGroup,
 		signedTransactions: make([][]byte, len(txs)),
+		authAddrs:          make([]types.Address, len(txs)),
 	}, nil
 }
 
+// SetAuthAddr marks transactions sent by a rekeyed account as authorized by the address it is rekeyed to,
+// so that they are signed by the key of the auth address and carry it in their signed transactions
+func (tg *TransactionGroup) SetAuthAddr(sender, authAddr string) error {
+	senderAddr, err := types.DecodeAddress(sender)
+	if err != nil {
+		return err
+	}
+	auth, err := types.DecodeAddress(authAddr)
+	if err != nil {
+		return err
+	}
+	if auth == senderAddr {
+		auth = types.Address{}
+	}
+
+	for idx, tx := range tg.transactions {
+		if tx.Sender == senderAddr {
+			tg.authAddrs[idx] = auth
+		}
+	}
+
+	return nil
+}
+
+// AuthAddrs returns the addresses whose keys authorize the transactions, which is the sender unless it is rekeyed
+func (tg *TransactionGroup) AuthAddrs() []types.Address {
+	authAddrs := make([]types.Address, len(tg.transactions))
+	for idx, tx := range tg.transactions {
+		authAddrs[idx] = tx.Sender
+		if !tg.authAddrs[idx].IsZero() {
+			authAddrs[idx] = tg.authAddrs[idx]
+		}
+	}
+
+	return authAddrs
+}
+
 // Sign signs a transaction group with an account
 func (tg *TransactionGroup) Sign(acc *crypto.Account) error {
-	accAddr := acc.Address.String()
+	authAddrs := tg.AuthAddrs()
 	for idx, tx := range tg.transactions {
-		if tx.Sender.String() == accAddr {
+		if authAddrs[idx] == acc.Address {
 			_, stx, err := crypto.SignTransaction(acc.PrivateKey, tx)
 			if err != nil {
 				return err
@@ -57,8 +98,9 @@ func (tg *TransactionGroup) Sign(acc *crypto.Account) error {
 
 // SignWithPrivateKey signs a transaction group with a given private key if a given address matches
 func (tg *TransactionGroup) SignWithPrivateKey(address string, sk ed25519.PrivateKey) error {
+	authAddrs := tg.AuthAddrs()
 	for idx, tx := range tg.transactions {
-		if tx.Sender.String() == address {
+		if authAddrs[idx].String() == address {
 			_, stx, err := crypto.SignTransaction(sk, tx)
 			if err != nil {
 				return err
@@ -78,8 +120,9 @@ func (tg *TransactionGroup) SignWithLogicSig(account *crypto.LogicSigAccount) er
 		return err
 	}
 
+	authAddrs := tg.AuthAddrs()
 	for idx, tx := range tg.transactions {
-		if tx.Sender.String() == address.String() {
+		if authAddrs[idx] == address {
 			_, stx, err := crypto.SignLogicsigTransaction(account.Lsig, tx)
 			if err != nil {
 				return err
@@ -92,22 +135,61 @@ func (tg *TransactionGroup) SignWithLogicSig(account *crypto.LogicSigAccount) er
 	return nil
 }
 
+// SignWith signs a transaction group with signers, partial multisig signatures of the same transaction are merged
+func (tg *TransactionGroup) SignWith(ctx context.Context, signers ...Signer) error {
+	authAddrs := tg.AuthAddrs()
+	for _, signer := range signers {
+		signed, err := signer.SignTransactions(ctx, tg.transactions, authAddrs)
+		if err != nil {
+			return err
+		}
+
+		for idx, stx := range signed {
+			if idx < 0 || idx >= len(tg.transactions) {
+				return fmt.Errorf("signer returned an out of bound index %d", idx)
+			}
+
+			if err := tg.mergeAt(idx, stx); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// UnsignedIndexes returns indexes of transactions which are not signed yet, including multisig transactions below their threshold
+func (tg *TransactionGroup) UnsignedIndexes() []int {
+	var indexes []int
+	for idx, stx := range tg.signedTransactions {
+		if len(stx) == 0 || !fullySigned(stx) {
+			indexes = append(indexes, idx)
+		}
+	}
+
+	return indexes
+}
+
 // Submit sends a signed transaction groups to the blockchain
-func (tg *TransactionGroup) Submit(ctx context.Context, client *algod.Client, wait bool) (string, error) {
+func (tg *TransactionGroup) Submit(ctx context.Context, client tTypes.AlgodAPI, wait bool) (string, error) {
+	if unsigned := tg.UnsignedIndexes(); len(unsigned) > 0 {
+		return "", fmt.Errorf("transaction group has unsigned transactions at indexes %v", unsigned)
+	}
+
 	var signedGroup []byte
 	for _, signedTx := range tg.signedTransactions {
 		signedGroup = append(signedGroup, signedTx...)
 	}
 
-	pendingTxID, err := client.SendRawTransaction(signedGroup).Do(ctx)
+	pendingTxID, err := client.SendRawTransaction(ctx, signedGroup)
 	if err != nil {
-		return "", err
+		return "", DecodeRejection(err, tg.transactions)
 	}
 
 	if wait {
-		_, err := future.WaitForConfirmation(client, pendingTxID, constants.MaxWaitRound, ctx)
+		_, err := WaitForConfirmation(ctx, client, pendingTxID, constants.MaxWaitRound)
 		if err != nil {
-			return pendingTxID, err
+			return pendingTxID, DecodeRejection(err, tg.transactions)
 		}
 	}
 
@@ -134,3 +216,45 @@ func (tg *TransactionGroup) SetSignedTransactions(index int, signedTx []byte) er
 
 	return nil
 }
+
+// fullySigned checks whether a multisig transaction has enough signatures, other signed transactions are always fully signed
+func fullySigned(signedTx []byte) bool {
+	var stx types.SignedTxn
+	if err := msgpack.Decode(signedTx, &stx); err != nil || stx.Msig.Blank() {
+		return true
+	}
+
+	var signatures int
+	for _, subsig := range stx.Msig.Subsigs {
+		if subsig.Sig != (types.Signature{}) {
+			signatures++
+		}
+	}
+
+	return signatures >= int(stx.Msig.Threshold)
+}
+
+// mergeSignedTransaction merges partial multisig signatures, otherwise the incoming signed transaction replaces the current one
+func mergeSignedTransaction(current, incoming []byte) ([]byte, error) {
+	if len(current) == 0 {
+		return incoming, nil
+	}
+
+	var currentStx, incomingStx types.SignedTxn
+	if err := msgpack.Decode(current, &currentStx); err != nil {
+		return nil, err
+	}
+	if err := msgpack.Decode(incoming, &incomingStx); err != nil {
+		return nil, err
+	}
+	if currentStx.Msig.Blank() || incomingStx.Msig.Blank() {
+		return incoming, nil
+	}
+
+	_, merged, err := crypto.MergeMultisigTransactions(current, incoming)
+	if err != nil {
+		return nil, err
+	}
+
+	return merged, nil
+}
@@ -0,0 +1,330 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+// WalletTransaction is an ARC-1 wallet transaction of a sign request
+type WalletTransaction struct {
+	Txn      string            `json:"txn"`
+	AuthAddr string            `json:"authAddr,omitempty"`
+	Msig     *MultisigMetadata `json:"msig,omitempty"`
+	Signers  *[]string         `json:"signers,omitempty"`
+	Stxn     string            `json:"stxn,omitempty"`
+	Message  string            `json:"message,omitempty"`
+}
+
+// MultisigMetadata is an ARC-1 multisig metadata of a wallet transaction
+type MultisigMetadata struct {
+	Version   uint8    `json:"version"`
+	Threshold uint8    `json:"threshold"`
+	Addrs     []string `json:"addrs"`
+}
+
+// encodedTransactionGroup is a JSON representation of a transaction group
+type encodedTransactionGroup struct {
+	Transactions []string `json:"transactions"`
+}
+
+// EncodeMsgpack encodes a transaction group to concatenated msgpack signed transactions which is compatible with goal .tx and .stxn files,
+// unsigned transactions are encoded as signed transactions without signatures
+func (tg *TransactionGroup) EncodeMsgpack() []byte {
+	var encoded []byte
+	for idx := range tg.transactions {
+		encoded = append(encoded, tg.encodeTransaction(idx)...)
+	}
+
+	return encoded
+}
+
+// EncodeJSON encodes a transaction group to a JSON object holding base64 msgpack signed transactions
+func (tg *TransactionGroup) EncodeJSON() ([]byte, error) {
+	encoded := encodedTransactionGroup{Transactions: make([]string, len(tg.transactions))}
+	for idx := range tg.transactions {
+		encoded.Transactions[idx] = base64.StdEncoding.EncodeToString(tg.encodeTransaction(idx))
+	}
+
+	return json.Marshal(encoded)
+}
+
+// WalletTransactions returns an ARC-1 sign request of a transaction group,
+// transactions which are already signed (e.g. by the pool logic signature) are marked as not to be signed
+func (tg *TransactionGroup) WalletTransactions() ([]WalletTransaction, error) {
+	wtxns := make([]WalletTransaction, len(tg.transactions))
+	for idx, tx := range tg.transactions {
+		wtxns[idx].Txn = base64.StdEncoding.EncodeToString(msgpack.Encode(tx))
+		if authAddr := tg.authAddrs[idx]; !authAddr.IsZero() {
+			wtxns[idx].AuthAddr = authAddr.String()
+		}
+
+		signedTx := tg.signedTransactions[idx]
+		if len(signedTx) == 0 {
+			continue
+		}
+
+		var stx types.SignedTxn
+		if err := msgpack.Decode(signedTx, &stx); err != nil {
+			return nil, fmt.Errorf("failed to decode signed transaction at index %d: %w", idx, err)
+		}
+		if !stx.AuthAddr.IsZero() {
+			wtxns[idx].AuthAddr = stx.AuthAddr.String()
+		}
+		if !stx.Msig.Blank() && !fullySigned(signedTx) {
+			msig, err := multisigMetadata(stx.Msig)
+			if err != nil {
+				return nil, err
+			}
+
+			wtxns[idx].Msig = msig
+			continue
+		}
+
+		wtxns[idx].Signers = &[]string{}
+		wtxns[idx].Stxn = base64.StdEncoding.EncodeToString(signedTx)
+	}
+
+	return wtxns, nil
+}
+
+// MergeWalletSignatures merges a response of an ARC-1 sign request, a nil entry means the wallet did not sign the transaction
+func (tg *TransactionGroup) MergeWalletSignatures(signedTxs []*string) error {
+	if len(signedTxs) != len(tg.transactions) {
+		return fmt.Errorf("expected %d signed transactions, got %d", len(tg.transactions), len(signedTxs))
+	}
+
+	for idx, encoded := range signedTxs {
+		if encoded == nil {
+			continue
+		}
+
+		signedTx, err := base64.StdEncoding.DecodeString(*encoded)
+		if err != nil {
+			return fmt.Errorf("failed to decode signed transaction at index %d: %w", idx, err)
+		}
+		if err := tg.mergeAt(idx, signedTx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Merge merges signatures of another copy of the same transaction group, e.g. one signed on an air-gapped machine
+func (tg *TransactionGroup) Merge(other *TransactionGroup) error {
+	if len(other.transactions) != len(tg.transactions) {
+		return fmt.Errorf("expected a group of %d transactions, got %d", len(tg.transactions), len(other.transactions))
+	}
+
+	for idx, tx := range other.transactions {
+		if crypto.GetTxID(tx) != crypto.GetTxID(tg.transactions[idx]) {
+			return fmt.Errorf("transaction at index %d does not belong to the transaction group", idx)
+		}
+
+		if signedTx := other.signedTransactions[idx]; len(signedTx) > 0 {
+			if err := tg.mergeAt(idx, signedTx); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// DecodeTransactionGroupMsgpack decodes a transaction group from concatenated msgpack signed transactions
+func DecodeTransactionGroupMsgpack(data []byte) (*TransactionGroup, error) {
+	var stxs []types.SignedTxn
+	decoder := msgpack.NewDecoder(bytes.NewReader(data))
+	for {
+		var stx types.SignedTxn
+		if err := decoder.Decode(&stx); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to decode signed transaction at index %d: %w", len(stxs), err)
+		}
+
+		stxs = append(stxs, stx)
+	}
+
+	return newTransactionGroupFromSigned(stxs)
+}
+
+// DecodeTransactionGroupJSON decodes a transaction group encoded by EncodeJSON
+func DecodeTransactionGroupJSON(data []byte) (*TransactionGroup, error) {
+	var encoded encodedTransactionGroup
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return nil, err
+	}
+
+	stxs := make([]types.SignedTxn, len(encoded.Transactions))
+	for idx, encodedTx := range encoded.Transactions {
+		signedTx, err := base64.StdEncoding.DecodeString(encodedTx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode signed transaction at index %d: %w", idx, err)
+		}
+		if err := msgpack.Decode(signedTx, &stxs[idx]); err != nil {
+			return nil, fmt.Errorf("failed to decode signed transaction at index %d: %w", idx, err)
+		}
+	}
+
+	return newTransactionGroupFromSigned(stxs)
+}
+
+// DecodeWalletTransactions decodes a transaction group from an ARC-1 sign request
+func DecodeWalletTransactions(wtxns []WalletTransaction) (*TransactionGroup, error) {
+	stxs := make([]types.SignedTxn, len(wtxns))
+	for idx, wtxn := range wtxns {
+		encoded, err := base64.StdEncoding.DecodeString(wtxn.Txn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode transaction at index %d: %w", idx, err)
+		}
+
+		var tx types.Transaction
+		if err := msgpack.Decode(encoded, &tx); err != nil {
+			return nil, fmt.Errorf("failed to decode transaction at index %d: %w", idx, err)
+		}
+
+		stxs[idx].Txn = tx
+		if wtxn.AuthAddr != "" {
+			authAddr, err := types.DecodeAddress(wtxn.AuthAddr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode auth address at index %d: %w", idx, err)
+			}
+
+			stxs[idx].AuthAddr = authAddr
+		}
+		if wtxn.Stxn == "" {
+			continue
+		}
+
+		signedTx, err := base64.StdEncoding.DecodeString(wtxn.Stxn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode signed transaction at index %d: %w", idx, err)
+		}
+		if err := msgpack.Decode(signedTx, &stxs[idx]); err != nil {
+			return nil, fmt.Errorf("failed to decode signed transaction at index %d: %w", idx, err)
+		}
+		if crypto.GetTxID(stxs[idx].Txn) != crypto.GetTxID(tx) {
+			return nil, fmt.Errorf("signed transaction at index %d does not match its transaction", idx)
+		}
+	}
+
+	return newTransactionGroupFromSigned(stxs)
+}
+
+// newTransactionGroupFromSigned creates a transaction group from decoded signed transactions without recomputing the group id
+func newTransactionGroupFromSigned(stxs []types.SignedTxn) (*TransactionGroup, error) {
+	if len(stxs) == 0 {
+		return nil, fmt.Errorf("transaction group is empty")
+	}
+
+	txs := make([]types.Transaction, len(stxs))
+	signedTxs := make([][]byte, len(stxs))
+	authAddrs := make([]types.Address, len(stxs))
+	for idx, stx := range stxs {
+		txs[idx] = stx.Txn
+		authAddrs[idx] = stx.AuthAddr
+		if stx.Sig != (types.Signature{}) || !stx.Msig.Blank() || !stx.Lsig.Blank() {
+			signedTxs[idx] = msgpack.Encode(stx)
+		}
+	}
+
+	if err := verifyGroupID(txs); err != nil {
+		return nil, err
+	}
+
+	return &TransactionGroup{
+		transactions:       txs,
+		signedTransactions: signedTxs,
+		authAddrs:          authAddrs,
+	}, nil
+}
+
+// verifyGroupID checks that all transactions share the group id computed from the transactions,
+// a single transaction without a group id is also accepted
+func verifyGroupID(txs []types.Transaction) error {
+	if len(txs) == 1 && txs[0].Group == (types.Digest{}) {
+		return nil
+	}
+
+	gid := txs[0].Group
+	withoutGroup := make([]types.Transaction, len(txs))
+	for idx, tx := range txs {
+		if tx.Group != gid {
+			return fmt.Errorf("transaction at index %d has a different group id", idx)
+		}
+
+		tx.Group = types.Digest{}
+		withoutGroup[idx] = tx
+	}
+
+	expected, err := crypto.ComputeGroupID(withoutGroup)
+	if err != nil {
+		return err
+	}
+	if expected != gid {
+		return fmt.Errorf("group id does not match the transactions")
+	}
+
+	return nil
+}
+
+// encodeTransaction returns a signed transaction at a given index, or the transaction wrapped as a signed transaction without signatures
+// which keeps its auth address
+func (tg *TransactionGroup) encodeTransaction(idx int) []byte {
+	if signedTx := tg.signedTransactions[idx]; len(signedTx) > 0 {
+		return signedTx
+	}
+
+	return msgpack.Encode(types.SignedTxn{Txn: tg.transactions[idx], AuthAddr: tg.authAddrs[idx]})
+}
+
+// mergeAt merges a signed transaction at a given index after checking that it signs the same transaction
+func (tg *TransactionGroup) mergeAt(idx int, signedTx []byte) error {
+	var stx types.SignedTxn
+	if err := msgpack.Decode(signedTx, &stx); err != nil {
+		return fmt.Errorf("failed to decode signed transaction at index %d: %w", idx, err)
+	}
+	if crypto.GetTxID(stx.Txn) != crypto.GetTxID(tg.transactions[idx]) {
+		return fmt.Errorf("signed transaction at index %d does not match its transaction", idx)
+	}
+
+	merged, err := mergeSignedTransaction(tg.signedTransactions[idx], signedTx)
+	if err != nil {
+		return err
+	}
+
+	tg.signedTransactions[idx] = merged
+	if !stx.AuthAddr.IsZero() {
+		tg.authAddrs[idx] = stx.AuthAddr
+	}
+
+	return nil
+}
+
+// multisigMetadata converts a multisig signature to ARC-1 multisig metadata
+func multisigMetadata(msig types.MultisigSig) (*MultisigMetadata, error) {
+	metadata := &MultisigMetadata{
+		Version:   msig.Version,
+		Threshold: msig.Threshold,
+		Addrs:     make([]string, len(msig.Subsigs)),
+	}
+	for idx, subsig := range msig.Subsigs {
+		var addr types.Address
+		if len(subsig.Key) != len(addr) {
+			return nil, fmt.Errorf("invalid multisig public key at index %d", idx)
+		}
+
+		copy(addr[:], subsig.Key)
+		metadata.Addrs[idx] = addr.String()
+	}
+
+	return metadata, nil
+}
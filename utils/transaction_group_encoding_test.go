@@ -0,0 +1,156 @@
+package utils_test
+
+import (
+	"context"
+	"encoding/base64"
+	"reflect"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/future"
+	"github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+	"github.com/synycboom/tinyman-go-sdk/v1/simulator"
+)
+
+func TestTransactionGroupEncoding(t *testing.T) {
+	ctx := context.Background()
+	hot := crypto.GenerateAccount()
+	cold := crypto.GenerateAccount()
+	sim := simulator.New(constants.TestnetValidatorAppId)
+	var txs []types.Transaction
+	for _, sender := range []crypto.Account{hot, cold} {
+		if err := sim.SetBalance(sender.Address.String(), 0, 1000000); err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		sp, err := sim.SuggestedParams(ctx)
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+		tx, err := future.MakePaymentTxn(sender.Address.String(), hot.Address.String(), 1000, nil, "", sp)
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		txs = append(txs, tx)
+	}
+
+	txGroup, err := utils.NewTransactionGroup(txs)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if err := txGroup.Sign(&hot); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	// the cold wallet signs a msgpack copy, and returns it as JSON
+	coldGroup, err := utils.DecodeTransactionGroupMsgpack(txGroup.EncodeMsgpack())
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if !reflect.DeepEqual(coldGroup.Transactions(), txGroup.Transactions()) {
+		t.Fatalf("DecodeTransactionGroupMsgpack returned different transactions")
+	}
+	if !reflect.DeepEqual(coldGroup.UnsignedIndexes(), []int{1}) {
+		t.Fatalf("UnsignedIndexes returned wrong indexes %v", coldGroup.UnsignedIndexes())
+	}
+	if err := coldGroup.Sign(&cold); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	encoded, err := coldGroup.EncodeJSON()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	signedGroup, err := utils.DecodeTransactionGroupJSON(encoded)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if err := txGroup.Merge(signedGroup); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(txGroup.UnsignedIndexes()) != 0 {
+		t.Fatalf("UnsignedIndexes returned wrong indexes %v", txGroup.UnsignedIndexes())
+	}
+	if err := sim.Execute(txGroup); err != nil {
+		t.Errorf("Unexpected err %s", err.Error())
+	}
+
+	tampered := txs[1]
+	tampered.Amount++
+	tamperedGroup, err := utils.NewTransactionGroup([]types.Transaction{txs[0], tampered})
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	mixed := append(
+		msgpack.Encode(types.SignedTxn{Txn: txGroup.Transactions()[0]}),
+		msgpack.Encode(types.SignedTxn{Txn: tamperedGroup.Transactions()[1]})...,
+	)
+	if _, err := utils.DecodeTransactionGroupMsgpack(mixed); err == nil {
+		t.Errorf("DecodeTransactionGroupMsgpack should reject transactions of different groups")
+	}
+	if err := txGroup.Merge(tamperedGroup); err == nil {
+		t.Errorf("Merge should reject a different transaction group")
+	}
+}
+
+func TestWalletTransactions(t *testing.T) {
+	hot := crypto.GenerateAccount()
+	wallet := crypto.GenerateAccount()
+	sp := types.SuggestedParams{FirstRoundValid: 1, LastRoundValid: 1000, MinFee: 1000, GenesisHash: make([]byte, 32)}
+	var txs []types.Transaction
+	for _, sender := range []crypto.Account{hot, wallet} {
+		tx, err := future.MakePaymentTxn(sender.Address.String(), hot.Address.String(), 1000, nil, "", sp)
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		txs = append(txs, tx)
+	}
+
+	txGroup, err := utils.NewTransactionGroup(txs)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if err := txGroup.Sign(&hot); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	wtxns, err := txGroup.WalletTransactions()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if wtxns[0].Signers == nil || len(*wtxns[0].Signers) != 0 || wtxns[0].Stxn == "" {
+		t.Fatalf("WalletTransactions should mark a signed transaction as not to be signed")
+	}
+	if wtxns[1].Signers != nil || wtxns[1].Stxn != "" {
+		t.Fatalf("WalletTransactions should ask the wallet to sign an unsigned transaction")
+	}
+
+	// the wallet signs what it is asked to sign
+	walletGroup, err := utils.DecodeWalletTransactions(wtxns)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	response := make([]*string, len(wtxns))
+	for _, idx := range walletGroup.UnsignedIndexes() {
+		_, stx, err := crypto.SignTransaction(wallet.PrivateKey, walletGroup.Transactions()[idx])
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		encoded := base64.StdEncoding.EncodeToString(stx)
+		response[idx] = &encoded
+	}
+
+	if err := txGroup.MergeWalletSignatures(response); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(txGroup.UnsignedIndexes()) != 0 {
+		t.Errorf("UnsignedIndexes returned wrong indexes %v", txGroup.UnsignedIndexes())
+	}
+}
@@ -0,0 +1,157 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+
+	tTypes "github.com/synycboom/tinyman-go-sdk/types"
+)
+
+// PlanStep prepares transaction groups when a plan reaches it during Submit, after the previous groups are confirmed,
+// e.g. redeems of excess amounts read from the chain. It may return no groups.
+type PlanStep func(ctx context.Context) ([]*TransactionGroup, error)
+
+// TransactionPlan is an ordered list of transaction groups, e.g. pre-flight opt-ins followed by a Tinyman operation
+type TransactionPlan struct {
+	entries []planEntry
+
+	// signers are kept to sign groups prepared by steps
+	signers []Signer
+}
+
+// planEntry is either a prepared transaction group or a step which prepares groups on submission
+type planEntry struct {
+	group *TransactionGroup
+	step  PlanStep
+}
+
+// NewTransactionPlan creates a transaction plan which submits transaction groups in a given order
+func NewTransactionPlan(groups ...*TransactionGroup) *TransactionPlan {
+	tp := &TransactionPlan{}
+	for _, txGroup := range groups {
+		tp.Add(txGroup)
+	}
+
+	return tp
+}
+
+// Add appends a prepared transaction group to the plan
+func (tp *TransactionPlan) Add(txGroup *TransactionGroup) {
+	tp.entries = append(tp.entries, planEntry{group: txGroup})
+}
+
+// AddStep appends a step whose groups are prepared and signed by the signers of the plan when Submit reaches it
+func (tp *TransactionPlan) AddStep(step PlanStep) {
+	tp.entries = append(tp.entries, planEntry{step: step})
+}
+
+// Groups returns prepared transaction groups of the plan in submission order, groups of steps are only known on submission
+func (tp *TransactionPlan) Groups() []*TransactionGroup {
+	var groups []*TransactionGroup
+	for _, entry := range tp.entries {
+		if entry.group != nil {
+			groups = append(groups, entry.group)
+		}
+	}
+
+	return groups
+}
+
+// HasSteps reports whether the plan has steps, whose groups are only prepared on submission
+func (tp *TransactionPlan) HasSteps() bool {
+	for _, entry := range tp.entries {
+		if entry.step != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Sign signs all transaction groups of the plan with an account, which signs groups of steps as well
+func (tp *TransactionPlan) Sign(acc *crypto.Account) error {
+	for _, txGroup := range tp.Groups() {
+		if err := txGroup.Sign(acc); err != nil {
+			return err
+		}
+	}
+
+	tp.signers = append(tp.signers, NewAccountSigner(*acc))
+
+	return nil
+}
+
+// SignWith signs all transaction groups of the plan with signers, which sign groups of steps as well
+func (tp *TransactionPlan) SignWith(ctx context.Context, signers ...Signer) error {
+	for _, txGroup := range tp.Groups() {
+		if err := txGroup.SignWith(ctx, signers...); err != nil {
+			return err
+		}
+	}
+
+	tp.signers = append(tp.signers, signers...)
+
+	return nil
+}
+
+// Submit submits transaction groups in order and returns their pending transaction ids.
+// Every group except the last one is waited for, so the next group or step is evaluated after the previous one is confirmed.
+func (tp *TransactionPlan) Submit(ctx context.Context, client tTypes.AlgodAPI, wait bool) ([]string, error) {
+	return tp.SubmitWith(ctx, func(ctx context.Context, txGroup *TransactionGroup, wait bool) (string, error) {
+		return txGroup.Submit(ctx, client, wait)
+	}, wait)
+}
+
+// SubmitWith submits transaction groups in order like Submit with a function which submits a group, e.g. a client method
+// which dry-runs groups before submitting them
+func (tp *TransactionPlan) SubmitWith(
+	ctx context.Context,
+	submit func(ctx context.Context, txGroup *TransactionGroup, wait bool) (string, error),
+	wait bool,
+) ([]string, error) {
+	txIDs := make([]string, 0, len(tp.entries))
+	for idx, entry := range tp.entries {
+		last := idx == len(tp.entries)-1
+		groups := []*TransactionGroup{entry.group}
+		if entry.step != nil {
+			prepared, err := tp.prepareStep(ctx, entry.step)
+			if err != nil {
+				return txIDs, err
+			}
+
+			groups = prepared
+		}
+
+		for groupIdx, txGroup := range groups {
+			txID, err := submit(ctx, txGroup, wait || !last || groupIdx < len(groups)-1)
+			if err != nil {
+				return txIDs, err
+			}
+
+			txIDs = append(txIDs, txID)
+		}
+	}
+
+	return txIDs, nil
+}
+
+// prepareStep prepares groups of a step and signs them with the signers of the plan
+func (tp *TransactionPlan) prepareStep(ctx context.Context, step PlanStep) ([]*TransactionGroup, error) {
+	groups, err := step(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) > 0 && len(tp.signers) == 0 {
+		return nil, fmt.Errorf("a plan step requires the plan to be signed first")
+	}
+
+	for _, txGroup := range groups {
+		if err := txGroup.SignWith(ctx, tp.signers...); err != nil {
+			return nil, err
+		}
+	}
+
+	return groups, nil
+}
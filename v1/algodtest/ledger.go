@@ -0,0 +1,124 @@
+package algodtest
+
+import (
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	algoTypes "github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+)
+
+// firstAssetID is the first asset id assigned to assets created on a node
+const firstAssetID = 1000
+
+// Ledger holds balances, assets, applications and application local states of a node
+type Ledger struct {
+	// Accounts are account states by address
+	Accounts map[algoTypes.Address]*Account
+
+	// Assets are asset params by asset id
+	Assets map[uint64]models.AssetParams
+
+	// Apps are applications by app id, their programs never change so they are shared between ledger copies
+	Apps map[uint64]models.Application
+
+	// NextAssetID is the id of the next created asset
+	NextAssetID uint64
+}
+
+// Account is an account state
+type Account struct {
+	// Algo is the Algo balance in micro Algos
+	Algo uint64
+
+	// Assets are asset balances by asset id, an opted in asset has a zero balance
+	Assets map[uint64]uint64
+
+	// Local are uint local state values by app id and key, an opted in app has an empty state
+	Local map[uint64]map[string]uint64
+
+	// Created are ids of assets created by the account
+	Created []uint64
+}
+
+// NewLedger creates an empty ledger
+func NewLedger() *Ledger {
+	return &Ledger{
+		Accounts:    make(map[algoTypes.Address]*Account),
+		Assets:      make(map[uint64]models.AssetParams),
+		Apps:        make(map[uint64]models.Application),
+		NextAssetID: firstAssetID,
+	}
+}
+
+// Clone returns a deep copy of the ledger which can be changed without changing the ledger
+func (l *Ledger) Clone() *Ledger {
+	c := NewLedger()
+	c.NextAssetID = l.NextAssetID
+	for id, params := range l.Assets {
+		c.Assets[id] = params
+	}
+	for id, app := range l.Apps {
+		c.Apps[id] = app
+	}
+	for addr, acc := range l.Accounts {
+		copied := &Account{
+			Algo:    acc.Algo,
+			Assets:  make(map[uint64]uint64, len(acc.Assets)),
+			Local:   make(map[uint64]map[string]uint64, len(acc.Local)),
+			Created: append([]uint64(nil), acc.Created...),
+		}
+		for id, amount := range acc.Assets {
+			copied.Assets[id] = amount
+		}
+		for appID, state := range acc.Local {
+			copied.Local[appID] = make(map[string]uint64, len(state))
+			for k, v := range state {
+				copied.Local[appID][k] = v
+			}
+		}
+		c.Accounts[addr] = copied
+	}
+
+	return c
+}
+
+// Account returns the state of an address, an unknown address gets an empty account
+func (l *Ledger) Account(addr algoTypes.Address) *Account {
+	acc, ok := l.Accounts[addr]
+	if !ok {
+		acc = &Account{
+			Assets: make(map[uint64]uint64),
+			Local:  make(map[uint64]map[string]uint64),
+		}
+		l.Accounts[addr] = acc
+	}
+
+	return acc
+}
+
+// CreateAsset creates an asset whose total supply is held by its creator and returns its id
+func (l *Ledger) CreateAsset(creator algoTypes.Address, params models.AssetParams) uint64 {
+	assetID := l.NextAssetID
+	l.NextAssetID++
+	l.Assets[assetID] = params
+	acc := l.Account(creator)
+	acc.Assets[assetID] = params.Total
+	acc.Created = append(acc.Created, assetID)
+
+	return assetID
+}
+
+// MinBalance returns the minimum balance of an account from its asset holdings and app local states
+func (l *Ledger) MinBalance(addr algoTypes.Address) uint64 {
+	acc := l.Account(addr)
+	total := uint64(constants.MinBalancePerAccount + constants.MinBalancePerAsset*len(acc.Assets))
+	for appID := range acc.Local {
+		total += constants.MinBalancePerApp
+		if app, ok := l.Apps[appID]; ok {
+			schema := app.Params.LocalStateSchema
+			total += constants.MinBalancePerAppUint*schema.NumUint + constants.MinBalancePerAppByteSlice*schema.NumByteSlice
+		}
+	}
+
+	return total
+}
@@ -0,0 +1,693 @@
+// Package algodtest provides an in-memory Algorand node which can be used to test the SDK without a network
+package algodtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	algoTypes "github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+	"github.com/synycboom/tinyman-go-sdk/v1/contracts"
+)
+
+// Node is an in-memory implementation of types.AlgodAPI, types.IndexerAPI, types.DryrunAPI, types.StatusAPI and types.BlockAPI which can be seeded with accounts, assets and pools
+type Node struct {
+	mu       sync.Mutex
+	round    uint64
+	advanced chan struct{}
+	params   algoTypes.SuggestedParams
+	ledger   *Ledger
+	pending  map[string]models.PendingTransactionInfoResponse
+	sent     [][]algoTypes.SignedTxn
+	dryruns  []models.DryrunResponse
+	requests []models.DryrunRequest
+	blocks   map[uint64]algoTypes.Block
+
+	// OnSend is called with decoded signed transactions when a group is submitted, a returned error rejects the group
+	OnSend func(stxns []algoTypes.SignedTxn) error
+
+	// Apply applies a submitted group to a copy of the ledger in a given round and returns apply data of every transaction.
+	// The copy replaces the ledger and the group is confirmed in its own round and block unless an error is returned.
+	// Without Apply, submitted groups are confirmed in the current round without changing the ledger.
+	Apply func(l *Ledger, round uint64, stxns []algoTypes.SignedTxn) ([]algoTypes.ApplyData, error)
+}
+
+var (
+	_ types.AlgodAPI   = (*Node)(nil)
+	_ types.IndexerAPI = (*Node)(nil)
+	_ types.DryrunAPI  = (*Node)(nil)
+	_ types.StatusAPI  = (*Node)(nil)
+	_ types.BlockAPI   = (*Node)(nil)
+)
+
+// NewNode creates an empty in-memory node at round 1
+func NewNode() *Node {
+	return &Node{
+		round:    1,
+		advanced: make(chan struct{}),
+		params: algoTypes.SuggestedParams{
+			Fee:             0,
+			GenesisID:       "algodtest-v1",
+			GenesisHash:     make([]byte, 32),
+			FirstRoundValid: 1,
+			LastRoundValid:  1001,
+			FlatFee:         false,
+			MinFee:          1000,
+		},
+		ledger:  NewLedger(),
+		pending: make(map[string]models.PendingTransactionInfoResponse),
+		blocks:  make(map[uint64]algoTypes.Block),
+	}
+}
+
+// Round returns the current round
+func (n *Node) Round() uint64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.round
+}
+
+// SetRound sets the current round and wakes up StatusAfterBlock callers
+func (n *Node) SetRound(round uint64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.setRound(round)
+}
+
+func (n *Node) setRound(round uint64) {
+	n.round = round
+	n.params.FirstRoundValid = algoTypes.Round(round)
+	n.params.LastRoundValid = algoTypes.Round(round + 1000)
+	close(n.advanced)
+	n.advanced = make(chan struct{})
+}
+
+// SetSuggestedParams sets suggested params returned by the node
+func (n *Node) SetSuggestedParams(sp algoTypes.SuggestedParams) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.params = sp
+}
+
+// SetAccount replaces an account with the balances, uint local states and created assets of a given account
+func (n *Node) SetAccount(account models.Account) error {
+	addr, err := algoTypes.DecodeAddress(account.Address)
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	delete(n.ledger.Accounts, addr)
+	acc := n.ledger.Account(addr)
+	acc.Algo = account.Amount
+	for _, holding := range account.Assets {
+		acc.Assets[holding.AssetId] = holding.Amount
+	}
+	for _, ls := range account.AppsLocalState {
+		acc.Local[ls.Id] = make(map[string]uint64)
+		for _, kv := range ls.KeyValue {
+			key, err := base64.StdEncoding.DecodeString(kv.Key)
+			if err != nil {
+				return err
+			}
+
+			acc.Local[ls.Id][string(key)] = kv.Value.Uint
+		}
+	}
+	for _, asset := range account.CreatedAssets {
+		acc.Created = append(acc.Created, asset.Index)
+		n.setAsset(asset.Index, asset.Params)
+	}
+
+	return nil
+}
+
+// SetAsset creates or replaces an asset without moving any balance
+func (n *Node) SetAsset(assetID, decimals uint64, name, unitName string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.setAsset(assetID, models.AssetParams{
+		Decimals: decimals,
+		Name:     name,
+		UnitName: unitName,
+	})
+}
+
+func (n *Node) setAsset(assetID uint64, params models.AssetParams) {
+	n.ledger.Assets[assetID] = params
+	if assetID >= n.ledger.NextAssetID {
+		n.ledger.NextAssetID = assetID + 1
+	}
+}
+
+// CreateAsset creates a new asset whose total supply is held by the creator and returns its id
+func (n *Node) CreateAsset(creatorAddress string, total, decimals uint64, name, unitName string) (uint64, error) {
+	creator, err := algoTypes.DecodeAddress(creatorAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.ledger.CreateAsset(creator, models.AssetParams{
+		Creator:  creatorAddress,
+		Total:    total,
+		Decimals: decimals,
+		Name:     name,
+		UnitName: unitName,
+	}), nil
+}
+
+// SetBalance sets an asset balance of an address and opts it in the asset if needed, asset id 0 sets the Algo balance
+func (n *Node) SetBalance(address string, assetID, amount uint64) error {
+	addr, err := algoTypes.DecodeAddress(address)
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	acc := n.ledger.Account(addr)
+	if assetID == 0 {
+		acc.Algo = amount
+	} else {
+		acc.Assets[assetID] = amount
+	}
+
+	return nil
+}
+
+// Balance returns an asset balance of an address, asset id 0 returns the Algo balance
+func (n *Node) Balance(address string, assetID uint64) uint64 {
+	addr, err := algoTypes.DecodeAddress(address)
+	if err != nil {
+		return 0
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	acc, ok := n.ledger.Accounts[addr]
+	if !ok {
+		return 0
+	}
+	if assetID == 0 {
+		return acc.Algo
+	}
+
+	return acc.Assets[assetID]
+}
+
+// OptInApp opts an address in an application with an empty local state
+func (n *Node) OptInApp(address string, appID uint64) error {
+	addr, err := algoTypes.DecodeAddress(address)
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.localState(addr, appID)
+
+	return nil
+}
+
+// SetLocalStateInt sets an uint value in the local state of an address, the address is opted in the app if needed
+func (n *Node) SetLocalStateInt(address string, appID uint64, key []byte, value uint64) error {
+	addr, err := algoTypes.DecodeAddress(address)
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.localState(addr, appID)[string(key)] = value
+
+	return nil
+}
+
+// SetExcess sets an excess amount of a user in a pool
+func (n *Node) SetExcess(userAddress string, validatorAppID uint64, poolAddress string, assetID, amount uint64) error {
+	key, err := utils.ExcessAssetStateKey(poolAddress, assetID)
+	if err != nil {
+		return err
+	}
+
+	return n.SetLocalStateInt(userAddress, validatorAppID, key, amount)
+}
+
+// Excess returns an excess amount of a user in a pool
+func (n *Node) Excess(userAddress string, validatorAppID uint64, poolAddress string, assetID uint64) uint64 {
+	user, err := algoTypes.DecodeAddress(userAddress)
+	if err != nil {
+		return 0
+	}
+
+	key, err := utils.ExcessAssetStateKey(poolAddress, assetID)
+	if err != nil {
+		return 0
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	acc, ok := n.ledger.Accounts[user]
+	if !ok {
+		return 0
+	}
+
+	return acc.Local[validatorAppID][string(key)]
+}
+
+// SetPool creates or replaces a pool account from a given pool info and returns the pool address.
+// The pool holds its reserves, outstanding amounts and the unissued liquidity asset, so groups can be executed against it.
+// The liquidity asset is created as well when LiquidityAssetID is set.
+func (n *Node) SetPool(info types.PoolInfo) (string, error) {
+	poolAccount, err := contracts.PoolLogicSigAccount(info.ValidatorAppID, info.Asset1ID, info.Asset2ID)
+	if err != nil {
+		return "", err
+	}
+
+	poolAddress, err := poolAccount.Address()
+	if err != nil {
+		return "", err
+	}
+
+	address := poolAddress.String()
+	values := map[string]uint64{
+		"a1":  info.Asset1ID,
+		"a2":  info.Asset2ID,
+		"s1":  info.Asset1Reserves,
+		"s2":  info.Asset2Reserves,
+		"ilt": info.IssuedLiquidity,
+		"p":   info.UnclaimedProtocolFee,
+	}
+	outstanding := [][2]uint64{
+		{info.Asset1ID, info.OutstandingAsset1Amount},
+		{info.Asset2ID, info.OutstandingAsset2Amount},
+	}
+	if info.LiquidityAssetID > 0 {
+		outstanding = append(outstanding, [2]uint64{info.LiquidityAssetID, info.OutstandingLiquidityAssetAmount})
+	}
+	for _, o := range outstanding {
+		key, err := utils.OutstandingAssetStateKey(o[0])
+		if err != nil {
+			return "", err
+		}
+
+		values[string(key)] = o[1]
+	}
+	if info.IssuedLiquidity > 0 {
+		// the first mint stores the liquidity asset id
+		values["lt"] = info.LiquidityAssetID
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	delete(n.ledger.Accounts, poolAddress)
+	acc := n.ledger.Account(poolAddress)
+	acc.Algo = info.AlgoBalance
+	acc.Local[info.ValidatorAppID] = values
+	acc.Assets[info.Asset1ID] = info.Asset1Reserves + info.OutstandingAsset1Amount
+	if info.Asset2ID != 0 {
+		acc.Assets[info.Asset2ID] = info.Asset2Reserves + info.OutstandingAsset2Amount
+	}
+
+	if info.LiquidityAssetID > 0 {
+		n.setAsset(info.LiquidityAssetID, models.AssetParams{
+			Creator:  address,
+			Total:    constants.TotalLiquidityTokens,
+			Decimals: constants.LiquidityTokenDecimals,
+			Name:     info.LiquidityAssetName,
+			UnitName: contracts.LiquidityAssetUnitName(info.ValidatorAppID),
+		})
+		acc.Assets[info.LiquidityAssetID] = constants.TotalLiquidityTokens - info.IssuedLiquidity + info.OutstandingLiquidityAssetAmount
+		acc.Created = []uint64{info.LiquidityAssetID}
+	}
+
+	return address, nil
+}
+
+// SetApplication creates or replaces an application, the local state schema of the app is counted in the minimum balance of opted in accounts
+func (n *Node) SetApplication(app models.Application) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.ledger.Apps[app.Id] = app
+}
+
+// AddDryrunResponse queues a dryrun response, TealDryrun returns queued responses in order and repeats the last one
+func (n *Node) AddDryrunResponse(response models.DryrunResponse) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.dryruns = append(n.dryruns, response)
+}
+
+// AddDryrunResponseJSON queues a dryrun response recorded from the /v2/teal/dryrun endpoint
+func (n *Node) AddDryrunResponseJSON(data []byte) error {
+	var response models.DryrunResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return err
+	}
+
+	n.AddDryrunResponse(response)
+
+	return nil
+}
+
+// DryrunRequests returns all received dryrun requests
+func (n *Node) DryrunRequests() []models.DryrunRequest {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.requests
+}
+
+// SentTransactions returns all submitted transaction groups
+func (n *Node) SentTransactions() [][]algoTypes.SignedTxn {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.sent
+}
+
+// AccountInformation returns account information of a given address
+func (n *Node) AccountInformation(ctx context.Context, address string) (models.Account, error) {
+	addr, err := algoTypes.DecodeAddress(address)
+	if err != nil {
+		return models.Account{}, err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.accountInformation(addr), nil
+}
+
+// GetApplicationByID returns application information of a given application id
+func (n *Node) GetApplicationByID(ctx context.Context, appID uint64) (models.Application, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	app, ok := n.ledger.Apps[appID]
+	if !ok {
+		return models.Application{}, fmt.Errorf("application does not exist")
+	}
+
+	return app, nil
+}
+
+// TealDryrun records a dryrun request and returns the next queued response
+func (n *Node) TealDryrun(ctx context.Context, request models.DryrunRequest) (models.DryrunResponse, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.requests = append(n.requests, request)
+	if len(n.dryruns) == 0 {
+		return models.DryrunResponse{}, fmt.Errorf("no dryrun response")
+	}
+
+	response := n.dryruns[0]
+	if len(n.dryruns) > 1 {
+		n.dryruns = n.dryruns[1:]
+	}
+
+	return response, nil
+}
+
+// SearchAccountsByApp returns a page of accounts opted into a given application ordered by address,
+// the next token is the last address of the page like the indexer does
+func (n *Node) SearchAccountsByApp(ctx context.Context, appID, limit uint64, next string) (models.AccountsResponse, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var addresses []string
+	for addr, acc := range n.ledger.Accounts {
+		if _, ok := acc.Local[appID]; !ok {
+			continue
+		}
+		if address := addr.String(); address > next {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+	if limit > 0 && uint64(len(addresses)) > limit {
+		addresses = addresses[:limit]
+	}
+
+	res := models.AccountsResponse{CurrentRound: n.round}
+	for _, address := range addresses {
+		addr, _ := algoTypes.DecodeAddress(address)
+		res.Accounts = append(res.Accounts, n.accountInformation(addr))
+		res.NextToken = address
+	}
+
+	return res, nil
+}
+
+// GetAssetByID returns asset information of a given asset id
+func (n *Node) GetAssetByID(ctx context.Context, assetID uint64) (models.Asset, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	params, ok := n.ledger.Assets[assetID]
+	if !ok {
+		return models.Asset{}, fmt.Errorf("asset does not exist")
+	}
+
+	return models.Asset{Index: assetID, Params: params}, nil
+}
+
+// SuggestedParams returns the suggested parameters for constructing a new transaction
+func (n *Node) SuggestedParams(ctx context.Context) (algoTypes.SuggestedParams, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.params, nil
+}
+
+// SendRawTransaction decodes submitted signed transactions, passes them to OnSend and Apply and marks them as confirmed
+func (n *Node) SendRawTransaction(ctx context.Context, rawTxn []byte) (string, error) {
+	var stxns []algoTypes.SignedTxn
+	dec := msgpack.NewDecoder(bytes.NewReader(rawTxn))
+	for {
+		var stxn algoTypes.SignedTxn
+		if err := dec.Decode(&stxn); err == io.EOF {
+			break
+		} else if err != nil {
+			return "", err
+		}
+
+		stxns = append(stxns, stxn)
+	}
+
+	if len(stxns) == 0 {
+		return "", fmt.Errorf("no transactions were submitted")
+	}
+
+	if n.OnSend != nil {
+		if err := n.OnSend(stxns); err != nil {
+			return "", err
+		}
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	ads := make([]algoTypes.ApplyData, len(stxns))
+	if n.Apply != nil {
+		l := n.ledger.Clone()
+		applied, err := n.Apply(l, n.round, stxns)
+		if err != nil {
+			return "", err
+		}
+
+		n.ledger = l
+		copy(ads, applied)
+	}
+
+	n.sent = append(n.sent, stxns)
+	for idx, stxn := range stxns {
+		n.pending[crypto.GetTxID(stxn.Txn)] = models.PendingTransactionInfoResponse{
+			ConfirmedRound: n.round,
+			Transaction:    stxn,
+			AssetIndex:     ads[idx].ConfigAsset,
+		}
+	}
+	if n.Apply != nil {
+		block := n.blocks[n.round]
+		block.Round = algoTypes.Round(n.round)
+		block.GenesisID = n.params.GenesisID
+		for idx, stxn := range stxns {
+			stib := algoTypes.SignedTxnInBlock{}
+			stib.SignedTxn = stxn
+			stib.ApplyData = ads[idx]
+			block.Payset = append(block.Payset, stib)
+		}
+		n.blocks[n.round] = block
+		n.setRound(n.round + 1)
+	}
+
+	return crypto.GetTxID(stxns[0].Txn), nil
+}
+
+// PendingTransactionInformation returns information of a submitted transaction
+func (n *Node) PendingTransactionInformation(ctx context.Context, txID string) (models.PendingTransactionInfoResponse, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	info, ok := n.pending[txID]
+	if !ok {
+		return models.PendingTransactionInfoResponse{}, fmt.Errorf("HTTP 404: transaction %s is not found", txID)
+	}
+
+	return info, nil
+}
+
+// localState returns the local state of an address in an app, the address is opted in the app if needed
+func (n *Node) localState(addr algoTypes.Address, appID uint64) map[string]uint64 {
+	acc := n.ledger.Account(addr)
+	state, ok := acc.Local[appID]
+	if !ok {
+		state = make(map[string]uint64)
+		acc.Local[appID] = state
+	}
+
+	return state
+}
+
+// accountInformation renders the ledger state of an address as a fresh account, so callers cannot change the ledger through it
+func (n *Node) accountInformation(addr algoTypes.Address) models.Account {
+	res := models.Account{Address: addr.String(), Round: n.round, Status: "Offline"}
+	acc, ok := n.ledger.Accounts[addr]
+	if !ok {
+		return res
+	}
+
+	res.Amount = acc.Algo
+	res.AmountWithoutPendingRewards = acc.Algo
+	for _, assetID := range sortedKeys(acc.Assets) {
+		res.Assets = append(res.Assets, models.AssetHolding{
+			AssetId: assetID,
+			Amount:  acc.Assets[assetID],
+		})
+	}
+	for _, appID := range sortedKeys(acc.Local) {
+		ls := models.ApplicationLocalState{Id: appID}
+		if app, ok := n.ledger.Apps[appID]; ok {
+			ls.Schema = app.Params.LocalStateSchema
+			res.AppsTotalSchema.NumUint += app.Params.LocalStateSchema.NumUint
+			res.AppsTotalSchema.NumByteSlice += app.Params.LocalStateSchema.NumByteSlice
+		}
+
+		keys := make([]string, 0, len(acc.Local[appID]))
+		for k := range acc.Local[appID] {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			ls.KeyValue = append(ls.KeyValue, models.TealKeyValue{
+				Key:   base64.StdEncoding.EncodeToString([]byte(k)),
+				Value: models.TealValue{Type: 2, Uint: acc.Local[appID][k]},
+			})
+		}
+		res.AppsLocalState = append(res.AppsLocalState, ls)
+	}
+	for _, assetID := range acc.Created {
+		res.CreatedAssets = append(res.CreatedAssets, models.Asset{
+			Index:  assetID,
+			Params: n.ledger.Assets[assetID],
+		})
+	}
+	res.TotalAssetsOptedIn = uint64(len(res.Assets))
+	res.TotalAppsOptedIn = uint64(len(res.AppsLocalState))
+	res.TotalCreatedAssets = uint64(len(res.CreatedAssets))
+
+	return res
+}
+
+func sortedKeys[V any](m map[uint64]V) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	return keys
+}
+
+// Status returns the current round as the node status
+func (n *Node) Status(ctx context.Context) (models.NodeStatus, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return models.NodeStatus{LastRound: n.round}, nil
+}
+
+// StatusAfterBlock waits until SetRound moves the node past a given round
+func (n *Node) StatusAfterBlock(ctx context.Context, round uint64) (models.NodeStatus, error) {
+	for {
+		n.mu.Lock()
+		current, advanced := n.round, n.advanced
+		n.mu.Unlock()
+
+		if current > round {
+			return models.NodeStatus{LastRound: current}, nil
+		}
+
+		select {
+		case <-advanced:
+		case <-ctx.Done():
+			return models.NodeStatus{}, ctx.Err()
+		}
+	}
+}
+
+// SetBlock stores a confirmed block of its round, groups executed by Apply are stored in blocks of their rounds as well
+func (n *Node) SetBlock(block algoTypes.Block) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.blocks[uint64(block.Round)] = block
+}
+
+// Block returns a stored block of a given round, a round without a stored block is an empty block
+func (n *Node) Block(ctx context.Context, round uint64) (algoTypes.Block, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if round > n.round {
+		return algoTypes.Block{}, fmt.Errorf("round %d is not confirmed yet", round)
+	}
+
+	block, ok := n.blocks[round]
+	if !ok {
+		block.Round = algoTypes.Round(round)
+	}
+
+	return block, nil
+}
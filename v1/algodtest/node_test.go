@@ -0,0 +1,40 @@
+package algodtest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+
+	"github.com/synycboom/tinyman-go-sdk/v1/algodtest"
+)
+
+func TestAccountInformationIsolation(t *testing.T) {
+	node := algodtest.NewNode()
+	address := crypto.GenerateAccount().Address.String()
+	if err := node.SetBalance(address, 10, 100); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if err := node.SetLocalStateInt(address, 20, []byte("key"), 1); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	account, err := node.AccountInformation(context.Background(), address)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	account.Assets[0].Amount = 0
+	account.AppsLocalState[0].KeyValue[0].Value.Uint = 0
+
+	// a returned account is rendered from the ledger, so changing it does not change the node
+	if node.Balance(address, 10) != 100 {
+		t.Errorf("AccountInformation shares asset holdings with the ledger")
+	}
+	account, err = node.AccountInformation(context.Background(), address)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if account.AppsLocalState[0].KeyValue[0].Value.Uint != 1 {
+		t.Errorf("AccountInformation shares local states with the ledger")
+	}
+}
@@ -19,46 +19,60 @@ import (
 
 // Client represents the Tinyman client
 type Client struct {
-	assetCache map[uint64]types.Asset
-	ac         *algod.Client
+	ac types.AlgodAPI
 
 	UserAddress    string
 	ValidatorAppID uint64
+
+	// DryrunBeforeSubmit makes Submit dry-run a group first and refuse to send it when the dryrun fails,
+	// the algod client has to implement types.DryrunAPI
+	DryrunBeforeSubmit bool
+
+	// SweepAfterSubmit makes Submit redeem excess amounts of the user after a waited Tinyman operation is confirmed,
+	// the options have to hold a signer
+	SweepAfterSubmit *SweepOptions
+
+	// PoolCache is shared by pools fetched, listed or routed by the client so that quotes reuse fresh pool states,
+	// Submit invalidates states of pools which a submitted group touches
+	PoolCache *pools.StateCache
+
+	// Assets caches asset information of the client and of pools it creates, it can be shared by several clients
+	Assets *types.AssetRegistry
 }
 
 // NewClient create a Tinyman client
-func NewClient(ac *algod.Client, validatorAppID uint64, userAddress string) *Client {
+func NewClient(ac types.AlgodAPI, validatorAppID uint64, userAddress string) *Client {
 	return &Client{
 		ac:             ac,
 		ValidatorAppID: validatorAppID,
 		UserAddress:    userAddress,
-		assetCache:     make(map[uint64]types.Asset),
+		Assets:         types.NewAssetRegistry(ac, types.DefaultAssetRegistrySize, 0),
 	}
 }
 
 // NewTestNetClient create a test net Tinyman client
-func NewTestNetClient(ac *algod.Client, userAddress string) (*Client, error) {
+func NewTestNetClient(ac types.AlgodAPI, userAddress string) (*Client, error) {
 	if ac == nil {
 		a, err := algod.MakeClient(constants.AlgodTestnetHost, "")
 		if err != nil {
 			return nil, err
 		}
 
-		ac = a
+		ac = utils.NewAlgodAPI(a)
 	}
 
 	return NewClient(ac, constants.TestnetValidatorAppId, userAddress), nil
 }
 
 // NewMainNetClient create a main net Tinyman client
-func NewMainNetClient(ac *algod.Client, userAddress string) (*Client, error) {
+func NewMainNetClient(ac types.AlgodAPI, userAddress string) (*Client, error) {
 	if ac == nil {
 		a, err := algod.MakeClient(constants.AlgodMainnetHost, "")
 		if err != nil {
 			return nil, err
 		}
 
-		ac = a
+		ac = utils.NewAlgodAPI(a)
 	}
 
 	return NewClient(ac, constants.MainnetValidatorAppId, userAddress), nil
@@ -70,34 +84,110 @@ func (c *Client) FetchPool(ctx context.Context, asset1, asset2 *types.Asset, fet
 		return nil, fmt.Errorf("asset1 and asset2 are required")
 	}
 
-	return pools.NewPool(ctx, c.ac, asset1, asset2, nil, c.ValidatorAppID, c.UserAddress, fetch)
-}
+	if c.PoolCache == nil {
+		return pools.NewPool(ctx, c.cachedAlgod(), asset1, asset2, nil, c.ValidatorAppID, c.UserAddress, fetch)
+	}
 
-// FetchAsset fetches an asset for a given asset id
-func (c *Client) FetchAsset(ctx context.Context, assetID uint64) (*types.Asset, error) {
-	if _, ok := c.assetCache[assetID]; !ok {
-		asset := types.Asset{
-			ID: assetID,
-		}
-		if err := asset.Fetch(ctx, c.ac); err != nil {
+	pool, err := pools.NewPool(ctx, c.cachedAlgod(), asset1, asset2, nil, c.ValidatorAppID, c.UserAddress, false)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.UseCache(c.PoolCache)
+	if fetch {
+		if err := pool.RefreshIfStale(ctx); err != nil {
 			return nil, err
 		}
+	}
+
+	return pool, nil
+}
+
+// ListPools lists pools of the validator app found in a given source and returns a token of the next page
+func (c *Client) ListPools(ctx context.Context, source pools.Source, opts *pools.ListOptions) ([]*pools.Pool, string, error) {
+	listed, next, err := pools.List(ctx, c.cachedAlgod(), source, c.ValidatorAppID, c.UserAddress, opts)
+	if err != nil {
+		return nil, "", err
+	}
 
-		c.assetCache[assetID] = asset
+	if c.PoolCache != nil {
+		for _, pool := range listed {
+			info, err := pool.Info()
+			if err != nil {
+				return nil, "", err
+			}
+
+			pool.UseCache(c.PoolCache)
+			c.PoolCache.Put(info)
+		}
 	}
 
-	asset := c.assetCache[assetID]
+	return listed, next, nil
+}
+
+// NewWatcher creates a watcher of pools which follows the node of the client, the algod client has to implement types.StatusAPI
+func (c *Client) NewWatcher(watched []*pools.Pool, thresholds []pools.PriceThreshold, bufferSize int) (*pools.Watcher, error) {
+	return pools.NewWatcher(c.ac, watched, thresholds, bufferSize)
+}
+
+// FetchAsset fetches an asset for a given asset id
+func (c *Client) FetchAsset(ctx context.Context, assetID uint64) (*types.Asset, error) {
+	asset := types.Asset{
+		ID: assetID,
+	}
+	if err := asset.Fetch(ctx, c.cachedAlgod()); err != nil {
+		return nil, err
+	}
 
 	return &asset, nil
 }
 
-// Submit submits a transaction group to the blockchain
+// cachedAlgod returns the algod client handed to pools and asset lookups, it is the asset registry when the client has one
+func (c *Client) cachedAlgod() types.AlgodAPI {
+	if c.Assets != nil {
+		return c.Assets
+	}
+
+	return c.ac
+}
+
+// Dryrun dry-runs a transaction group against the node and returns a report
+func (c *Client) Dryrun(ctx context.Context, txGroup *utils.TransactionGroup) (*types.DryrunReport, error) {
+	return txGroup.Dryrun(ctx, c.ac)
+}
+
+// Submit submits a transaction group to the blockchain, a group which fails a dryrun returns *types.DryrunFailedError when DryrunBeforeSubmit is set
 func (c *Client) Submit(ctx context.Context, txGroup *utils.TransactionGroup, wait bool) (string, error) {
+	if c.DryrunBeforeSubmit {
+		report, err := c.Dryrun(ctx, txGroup)
+		if err != nil {
+			return "", err
+		}
+		if !report.Passed {
+			return "", &types.DryrunFailedError{Report: report}
+		}
+	}
+
 	txID, err := txGroup.Submit(ctx, c.ac, wait)
 	if err != nil {
 		return "", err
 	}
 
+	if c.PoolCache != nil {
+		var addresses []string
+		for _, tx := range txGroup.Transactions() {
+			addresses = append(addresses, tx.Sender.String())
+		}
+
+		c.PoolCache.Invalidate(addresses...)
+	}
+
+	if wait && c.SweepAfterSubmit != nil {
+		if err := c.sweepAfterSubmit(ctx, txGroup); err != nil {
+			return txID, fmt.Errorf("transaction group was confirmed but the excess sweep failed: %w", err)
+		}
+	}
+
 	return txID, nil
 }
 
@@ -107,7 +197,7 @@ func (c *Client) PrepareAppOptInTransaction(ctx context.Context, userAddress str
 		userAddress = c.UserAddress
 	}
 
-	sp, err := c.ac.SuggestedParams().Do(ctx)
+	sp, err := c.ac.SuggestedParams(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +211,7 @@ func (c *Client) PrepareAssetOptInTransactions(ctx context.Context, assetID uint
 		userAddress = c.UserAddress
 	}
 
-	sp, err := c.ac.SuggestedParams().Do(ctx)
+	sp, err := c.ac.SuggestedParams(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -141,8 +231,7 @@ func (c *Client) FetchExcessAmount(ctx context.Context, userAddr string) ([]type
 		userAddr = c.UserAddress
 	}
 
-	accountInfo := c.ac.AccountInformation(userAddr)
-	account, err := accountInfo.Do(ctx)
+	account, err := c.ac.AccountInformation(ctx, userAddr)
 	if err != nil {
 		return quotes, err
 	}
@@ -202,8 +291,7 @@ func (c *Client) IsOptedIn(ctx context.Context, userAddr string) (bool, error) {
 		userAddr = c.UserAddress
 	}
 
-	accountInfo := c.ac.AccountInformation(userAddr)
-	account, err := accountInfo.Do(ctx)
+	account, err := c.ac.AccountInformation(ctx, userAddr)
 	if err != nil {
 		return false, err
 	}
@@ -223,8 +311,7 @@ func (c *Client) IsAssetOptedIn(ctx context.Context, assetID uint64, userAddr st
 		userAddr = c.UserAddress
 	}
 
-	accountInfo := c.ac.AccountInformation(userAddr)
-	account, err := accountInfo.Do(ctx)
+	account, err := c.ac.AccountInformation(ctx, userAddr)
 	if err != nil {
 		return false, err
 	}
@@ -244,8 +331,7 @@ func (c *Client) Balance(ctx context.Context, asset *types.Asset, userAddress st
 		userAddress = c.UserAddress
 	}
 
-	accountInfo := c.ac.AccountInformation(userAddress)
-	account, err := accountInfo.Do(ctx)
+	account, err := c.ac.AccountInformation(ctx, userAddress)
 	if err != nil {
 		return nil, err
 	}
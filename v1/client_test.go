@@ -0,0 +1,157 @@
+package tinyman_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+	"github.com/synycboom/tinyman-go-sdk/v1/pools"
+	"github.com/synycboom/tinyman-go-sdk/v1/tinymantest"
+)
+
+const (
+	// dryrunPassJSON is a dryrun response of a swap recorded from /v2/teal/dryrun
+	dryrunPassJSON = `{"error":"","protocol-version":"future","txns":[
+		{"disassembly":null},
+		{"app-call-messages":["ApprovalProgram","PASS"],"cost":612,"disassembly":null,"logs":["c3dhcA=="],
+		 "local-deltas":[{"address":"%s","delta":[{"key":"czE=","value":{"action":2,"uint":1998007986}}]}]},
+		{"disassembly":null},
+		{"disassembly":null,"logic-sig-messages":["PASS"]}]}`
+
+	// dryrunRejectJSON is a dryrun response of a swap rejected by the validator
+	dryrunRejectJSON = `{"error":"","protocol-version":"future","txns":[
+		{"disassembly":null},
+		{"app-call-messages":["ApprovalProgram","REJECT"],"cost":598,"disassembly":null},
+		{"disassembly":null},
+		{"disassembly":null,"logic-sig-messages":["PASS"]}]}`
+)
+
+// signedSwap seeds an ALGO/USDC pool and returns a swap group of the fixture user with the pool address
+func signedSwap(t *testing.T, e *tinymantest.Fixture) (*utils.TransactionGroup, string) {
+	e.Sim.SetAsset(10458941, 6, "USDC", "USDC")
+	pool := e.SeedPool(types.PoolInfo{
+		Asset1ID:         10458941,
+		Asset2ID:         0,
+		LiquidityAssetID: 62368708,
+		Asset1Reserves:   2000000000,
+		Asset2Reserves:   1000000000,
+		IssuedLiquidity:  1000000000,
+		ValidatorAppID:   constants.TestnetValidatorAppId,
+		AlgoBalance:      2000000000,
+	})
+	poolAddress, err := pool.Address()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	quote, err := pool.FetchFixedInputSwapQuote(e.Ctx, &types.AssetAmount{Asset: pool.Asset2, Amount: 1000000}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	txGroup, err := pool.PrepareSwapTransactionsFromQuote(e.Ctx, quote, "")
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if err := txGroup.Sign(&e.User); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	return txGroup, poolAddress
+}
+
+func TestDryrun(t *testing.T) {
+	e := tinymantest.New(t)
+	txGroup, poolAddress := signedSwap(t, e)
+	if err := e.Sim.AddDryrunResponseJSON([]byte(fmt.Sprintf(dryrunPassJSON, poolAddress))); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	report, err := e.Client.Dryrun(e.Ctx, txGroup)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if !report.Passed || len(report.Transactions) != 4 {
+		t.Fatalf("Dryrun returned wrong report")
+	}
+
+	appCall := report.Transactions[1]
+	if appCall.Cost != 612 || string(appCall.Logs[0]) != "swap" {
+		t.Errorf("Dryrun returned wrong app call result")
+	}
+	if len(appCall.LocalDeltas) != 1 || appCall.LocalDeltas[0].Address != poolAddress ||
+		string(appCall.LocalDeltas[0].Deltas[0].Key) != "s1" || appCall.LocalDeltas[0].Deltas[0].Uint != 1998007986 {
+		t.Errorf("Dryrun returned wrong local deltas")
+	}
+	if len(report.Transfers) != 3 || report.Transfers[2].Sender != poolAddress || report.Transfers[2].AssetID != 10458941 {
+		t.Errorf("Dryrun returned wrong transfers")
+	}
+
+	requests := e.Sim.DryrunRequests()
+	if len(requests) != 1 || len(requests[0].Txns) != 4 || len(requests[0].Apps) != 1 {
+		t.Errorf("Dryrun sent a wrong request")
+	}
+}
+
+func TestSubmitRefusesFailedDryrun(t *testing.T) {
+	e := tinymantest.New(t)
+	txGroup, _ := signedSwap(t, e)
+	if err := e.Sim.AddDryrunResponseJSON([]byte(dryrunRejectJSON)); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	e.Client.DryrunBeforeSubmit = true
+	_, err := e.Client.Submit(e.Ctx, txGroup, true)
+
+	var dryrunErr *types.DryrunFailedError
+	if !errors.As(err, &dryrunErr) {
+		t.Fatalf("Submit should return a dryrun error, got %v", err)
+	}
+	if dryrunErr.Report.FirstFailure().GroupIndex != 1 {
+		t.Errorf("Dryrun report has a wrong failure")
+	}
+	if len(e.Sim.SentTransactions()) != 0 {
+		t.Errorf("Submit should not send a group which fails the dryrun")
+	}
+}
+
+func TestPoolCacheSeesOtherTrades(t *testing.T) {
+	e := tinymantest.New(t)
+	e.OptIn()
+	assetA, assetB := e.CreateAsset("AAA"), e.CreateAsset("BBB")
+	e.CreatePool(assetA, assetB, 50000000, 20000000)
+
+	e.Client.PoolCache = pools.NewStateCache(0, 0)
+	pool, err := e.Client.FetchPool(e.Ctx, assetA, assetB, true)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	reserves := pool.Asset1Reserves
+
+	// another account trades on the pool after the state was cached
+	other := e.NewUser()
+	other.OptIn()
+	for _, asset := range []*types.Asset{assetA, assetB} {
+		if err := e.Sim.SetBalance(other.Address(), asset.ID, 10000000); err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+	}
+	otherPool, err := other.Client.FetchPool(other.Ctx, assetA, assetB, true)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	quote, err := otherPool.FetchFixedInputSwapQuote(other.Ctx, &types.AssetAmount{Asset: otherPool.Asset1, Amount: 1000000}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	other.Submit(otherPool.PrepareSwapTransactionsFromQuote(other.Ctx, quote, ""))
+
+	if _, err := pool.FetchFixedInputSwapQuote(e.Ctx, &types.AssetAmount{Asset: pool.Asset1, Amount: 1000000}, 100); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if pool.Asset1Reserves != reserves+1000000 {
+		t.Errorf("A quote should see the trade of another account, got reserves %d instead of %d", pool.Asset1Reserves, reserves+1000000)
+	}
+}
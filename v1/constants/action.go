@@ -0,0 +1,24 @@
+package constants
+
+const (
+	// ActionBootstrap is a decoded pool bootstrap group
+	ActionBootstrap = "bootstrap"
+
+	// ActionSwap is a decoded swap group
+	ActionSwap = "swap"
+
+	// ActionMint is a decoded mint group
+	ActionMint = "mint"
+
+	// ActionBurn is a decoded burn group
+	ActionBurn = "burn"
+
+	// ActionRedeem is a decoded redeem group
+	ActionRedeem = "redeem"
+
+	// ActionFees is a decoded protocol fee claim group
+	ActionFees = "fees"
+
+	// ActionUnknown is a group calling the validator app which could not be decoded
+	ActionUnknown = "unknown"
+)
@@ -10,6 +10,18 @@ const (
 	// AlgodMainnetHost is the algorand main net url
 	AlgodMainnetHost = "https://mainnet-api.algonode.cloud"
 
+	// Version1_0 is the Tinyman contracts version 1.0
+	Version1_0 = "v1.0"
+
+	// Version1_1 is the Tinyman contracts version 1.1
+	Version1_1 = "v1.1"
+
+	// TestnetValidatorAppIdV1_0 is the Tinyman test net validator app id version 1.0
+	TestnetValidatorAppIdV1_0 uint64 = 21580889
+
+	// MainnetValidatorAppIdV1_0 is the Tinyman main net validator app id version 1.0
+	MainnetValidatorAppIdV1_0 uint64 = 350338509
+
 	// TestnetValidatorAppIdV1_1 is the Tinyman test net validator app id version 1.1
 	TestnetValidatorAppIdV1_1 uint64 = 62368684
 
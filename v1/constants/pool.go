@@ -5,10 +5,18 @@ const (
 
 	LiquidityTokenDecimals = 6
 
+	// LiquidityTokenUnitName is a unit name of liquidity assets created by Tinyman v1.0 pools
 	LiquidityTokenUnitName = "TM1POOL"
 
+	// LiquidityAssetUnitName is a unit name of liquidity assets created by Tinyman v1.1 pools
 	LiquidityAssetUnitName = "TMPOOL11"
 
+	// LiquidityTokenNamePrefix is a name prefix of liquidity assets created by Tinyman v1.0 pools
+	LiquidityTokenNamePrefix = "TinymanPool1.0"
+
+	// LiquidityAssetNamePrefix is a name prefix of liquidity assets created by Tinyman v1.1 pools
+	LiquidityAssetNamePrefix = "TinymanPool1.1"
+
 	MinBalancePerAccount = 100000
 
 	MinBalancePerAsset = 100000
@@ -15,3 +15,11 @@ var (
 		SwapFixedOutput: "fo",
 	}
 )
+
+const (
+	// BasisPoints is the number of basis points in a whole, slippage of 1% is 100 basis points
+	BasisPoints = 10000
+
+	// DefaultMaxRouteHops is a default maximum number of swaps in a route
+	DefaultMaxRouteHops = 3
+)
@@ -1,6 +1,11 @@
 package constants
 
+import "time"
+
 const (
 	// MaxWaitRound is a maximum waiting round used when waiting for a transaction to be confirmed
 	MaxWaitRound = 40
+
+	// WaitInterval is an interval between polls when waiting for a transaction to be confirmed, roughly one round
+	WaitInterval = 4 * time.Second
 )
@@ -2,13 +2,15 @@
 
 THISDIR=$(dirname $0)
 
-cat <<EOM | gofmt > $THISDIR/bundled_asc_inject.go
-// Code generated during build process, along with asc.json. DO NOT EDIT.
-package contracts
-var ascJson []byte
-func init() {
-        ascJson = []byte{
-        $(cat $THISDIR/asc-v1_1.json | hexdump -v -e '1/1 "0x%02X, "' | fmt)
-        }
-}
-EOM
+{
+  echo "// Code generated during build process, along with asc-v*.json. DO NOT EDIT."
+  echo "package contracts"
+  echo "var bundledASCJson = map[string][]byte{"
+  for FILE in $THISDIR/asc-v*.json; do
+    VERSION=$(basename $FILE .json | sed 's/^asc-//; s/_/./')
+    echo "\"$VERSION\": {"
+    od -An -v -tx1 $FILE | tr 'abcdef' 'ABCDEF' | sed 's/ *\([0-9A-F][0-9A-F]\)/0x\1, /g'
+    echo "},"
+  done
+  echo "}"
+} | gofmt > $THISDIR/bundled_asc_inject.go
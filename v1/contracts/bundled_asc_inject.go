@@ -1,419 +1,315 @@
-// Code generated during build process, along with asc.json. DO NOT EDIT.
+// Code generated during build process, along with asc-v*.json. DO NOT EDIT.
 package contracts
 
-var ascJson []byte
-
-func init() {
-	ascJson = []byte{
-		0x7B, 0x0A, 0x20, 0x20, 0x22, 0x72, 0x65, 0x70, 0x6F, 0x22, 0x3A, 0x20,
-		0x22, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3A, 0x2F, 0x2F, 0x67, 0x69, 0x74,
-		0x68, 0x75, 0x62, 0x2E, 0x63, 0x6F, 0x6D, 0x2F, 0x74, 0x69, 0x6E, 0x79,
-		0x6D, 0x61, 0x6E, 0x6F, 0x72, 0x67, 0x2F, 0x74, 0x69, 0x6E, 0x79, 0x6D,
-		0x61, 0x6E, 0x2D, 0x63, 0x6F, 0x6E, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73,
-		0x2D, 0x76, 0x31, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x22, 0x72, 0x65, 0x66,
-		0x22, 0x3A, 0x20, 0x22, 0x64, 0x63, 0x39, 0x61, 0x62, 0x34, 0x30, 0x63,
-		0x35, 0x38, 0x62, 0x38, 0x35, 0x63, 0x31, 0x35, 0x64, 0x35, 0x38, 0x66,
-		0x36, 0x33, 0x61, 0x31, 0x35, 0x30, 0x37, 0x65, 0x31, 0x38, 0x62, 0x65,
-		0x37, 0x36, 0x37, 0x32, 0x30, 0x64, 0x62, 0x62, 0x22, 0x2C, 0x0A, 0x20,
-		0x20, 0x22, 0x63, 0x6F, 0x6E, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x22,
-		0x3A, 0x20, 0x7B, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x22, 0x70, 0x6F, 0x6F,
-		0x6C, 0x5F, 0x6C, 0x6F, 0x67, 0x69, 0x63, 0x73, 0x69, 0x67, 0x22, 0x3A,
-		0x20, 0x7B, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79,
-		0x70, 0x65, 0x22, 0x3A, 0x20, 0x22, 0x6C, 0x6F, 0x67, 0x69, 0x63, 0x73,
-		0x69, 0x67, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
-		0x6C, 0x6F, 0x67, 0x69, 0x63, 0x22, 0x3A, 0x20, 0x7B, 0x0A, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62, 0x79, 0x74, 0x65, 0x63,
-		0x6F, 0x64, 0x65, 0x22, 0x3A, 0x20, 0x22, 0x42, 0x43, 0x41, 0x49, 0x41,
-		0x51, 0x43, 0x42, 0x67, 0x49, 0x43, 0x41, 0x67, 0x49, 0x43, 0x41, 0x67,
-		0x50, 0x41, 0x42, 0x67, 0x49, 0x43, 0x41, 0x67, 0x49, 0x43, 0x41, 0x67,
-		0x49, 0x44, 0x77, 0x41, 0x51, 0x4D, 0x45, 0x42, 0x51, 0x59, 0x6C, 0x4A,
-		0x41, 0x31, 0x45, 0x4D, 0x51, 0x6B, 0x79, 0x41, 0x78, 0x4A, 0x45, 0x4D,
-		0x52, 0x55, 0x79, 0x41, 0x78, 0x4A, 0x45, 0x4D, 0x53, 0x41, 0x79, 0x41,
-		0x78, 0x4A, 0x45, 0x4D, 0x67, 0x51, 0x69, 0x44, 0x55, 0x51, 0x7A, 0x41,
-		0x51, 0x41, 0x78, 0x41, 0x42, 0x4A, 0x45, 0x4D, 0x77, 0x45, 0x51, 0x49,
-		0x51, 0x63, 0x53, 0x52, 0x44, 0x4D, 0x42, 0x47, 0x49, 0x47, 0x43, 0x67,
-		0x49, 0x43, 0x41, 0x67, 0x49, 0x43, 0x41, 0x67, 0x50, 0x41, 0x42, 0x45,
-		0x6B, 0x51, 0x7A, 0x41, 0x52, 0x6B, 0x69, 0x45, 0x6A, 0x4D, 0x42, 0x47,
-		0x79, 0x45, 0x45, 0x45, 0x68, 0x41, 0x33, 0x41, 0x52, 0x6F, 0x41, 0x67,
-		0x41, 0x6C, 0x69, 0x62, 0x32, 0x39, 0x30, 0x63, 0x33, 0x52, 0x79, 0x59,
-		0x58, 0x41, 0x53, 0x45, 0x45, 0x41, 0x41, 0x58, 0x44, 0x4D, 0x42, 0x47,
-		0x53, 0x4D, 0x53, 0x52, 0x44, 0x4D, 0x42, 0x47, 0x34, 0x45, 0x43, 0x45,
-		0x6A, 0x63, 0x42, 0x47, 0x67, 0x43, 0x41, 0x42, 0x48, 0x4E, 0x33, 0x59,
-		0x58, 0x41, 0x53, 0x45, 0x45, 0x41, 0x43, 0x4F, 0x7A, 0x4D, 0x42, 0x47,
-		0x79, 0x49, 0x53, 0x52, 0x44, 0x63, 0x42, 0x47, 0x67, 0x43, 0x41, 0x42,
-		0x47, 0x31, 0x70, 0x62, 0x6E, 0x51, 0x53, 0x51, 0x41, 0x45, 0x37, 0x4E,
-		0x77, 0x45, 0x61, 0x41, 0x49, 0x41, 0x45, 0x59, 0x6E, 0x56, 0x79, 0x62,
-		0x68, 0x4A, 0x41, 0x41, 0x5A, 0x67, 0x33, 0x41, 0x52, 0x6F, 0x41, 0x67,
-		0x41, 0x5A, 0x79, 0x5A, 0x57, 0x52, 0x6C, 0x5A, 0x57, 0x30, 0x53, 0x51,
-		0x41, 0x4A, 0x62, 0x4E, 0x77, 0x45, 0x61, 0x41, 0x49, 0x41, 0x45, 0x5A,
-		0x6D, 0x56, 0x6C, 0x63, 0x78, 0x4A, 0x41, 0x41, 0x6E, 0x6B, 0x41, 0x49,
-		0x51, 0x59, 0x68, 0x42, 0x53, 0x51, 0x6A, 0x45, 0x6B, 0x30, 0x79, 0x42,
-		0x42, 0x4A, 0x45, 0x4E, 0x77, 0x45, 0x61, 0x41, 0x52, 0x63, 0x6C, 0x45,
-		0x6A, 0x63, 0x42, 0x47, 0x67, 0x49, 0x58, 0x4A, 0x42, 0x49, 0x51, 0x52,
-		0x44, 0x4D, 0x43, 0x41, 0x44, 0x45, 0x41, 0x45, 0x6B, 0x51, 0x7A, 0x41,
-		0x68, 0x41, 0x68, 0x42, 0x42, 0x4A, 0x45, 0x4D, 0x77, 0x49, 0x68, 0x49,
-		0x78, 0x4A, 0x45, 0x4D, 0x77, 0x49, 0x69, 0x49, 0x78, 0x77, 0x53, 0x52,
-		0x44, 0x4D, 0x43, 0x49, 0x79, 0x45, 0x48, 0x45, 0x6B, 0x51, 0x7A, 0x41,
-		0x69, 0x51, 0x6A, 0x45, 0x6B, 0x51, 0x7A, 0x41, 0x69, 0x57, 0x41, 0x43,
-		0x46, 0x52, 0x4E, 0x55, 0x45, 0x39, 0x50, 0x54, 0x44, 0x45, 0x78, 0x45,
-		0x6B, 0x51, 0x7A, 0x41, 0x69, 0x5A, 0x52, 0x41, 0x41, 0x2B, 0x41, 0x44,
-		0x31, 0x52, 0x70, 0x62, 0x6E, 0x6C, 0x74, 0x59, 0x57, 0x35, 0x51, 0x62,
-		0x32, 0x39, 0x73, 0x4D, 0x53, 0x34, 0x78, 0x49, 0x42, 0x4A, 0x45, 0x4D,
-		0x77, 0x49, 0x6E, 0x67, 0x42, 0x4E, 0x6F, 0x64, 0x48, 0x52, 0x77, 0x63,
-		0x7A, 0x6F, 0x76, 0x4C, 0x33, 0x52, 0x70, 0x62, 0x6E, 0x6C, 0x74, 0x59,
-		0x57, 0x34, 0x75, 0x62, 0x33, 0x4A, 0x6E, 0x45, 0x6B, 0x51, 0x7A, 0x41,
-		0x69, 0x6B, 0x79, 0x41, 0x78, 0x4A, 0x45, 0x4D, 0x77, 0x49, 0x71, 0x4D,
-		0x67, 0x4D, 0x53, 0x52, 0x44, 0x4D, 0x43, 0x4B, 0x7A, 0x49, 0x44, 0x45,
-		0x6B, 0x51, 0x7A, 0x41, 0x69, 0x77, 0x79, 0x41, 0x78, 0x4A, 0x45, 0x4D,
-		0x77, 0x4D, 0x41, 0x4D, 0x51, 0x41, 0x53, 0x52, 0x44, 0x4D, 0x44, 0x45,
-		0x43, 0x45, 0x46, 0x45, 0x6B, 0x51, 0x7A, 0x41, 0x78, 0x45, 0x6C, 0x45,
-		0x6B, 0x51, 0x7A, 0x41, 0x78, 0x51, 0x78, 0x41, 0x42, 0x4A, 0x45, 0x4D,
-		0x77, 0x4D, 0x53, 0x49, 0x78, 0x4A, 0x45, 0x4A, 0x43, 0x4D, 0x54, 0x51,
-		0x41, 0x41, 0x51, 0x4D, 0x77, 0x45, 0x42, 0x4D, 0x77, 0x49, 0x42, 0x43,
-		0x44, 0x4D, 0x44, 0x41, 0x51, 0x67, 0x31, 0x41, 0x55, 0x49, 0x42, 0x73,
-		0x54, 0x4D, 0x45, 0x41, 0x44, 0x45, 0x41, 0x45, 0x6B, 0x51, 0x7A, 0x42,
-		0x42, 0x41, 0x68, 0x42, 0x52, 0x4A, 0x45, 0x4D, 0x77, 0x51, 0x52, 0x4A,
-		0x42, 0x4A, 0x45, 0x4D, 0x77, 0x51, 0x55, 0x4D, 0x51, 0x41, 0x53, 0x52,
-		0x44, 0x4D, 0x45, 0x45, 0x69, 0x4D, 0x53, 0x52, 0x44, 0x4D, 0x42, 0x41,
-		0x54, 0x4D, 0x43, 0x41, 0x51, 0x67, 0x7A, 0x41, 0x77, 0x45, 0x49, 0x4D,
-		0x77, 0x51, 0x42, 0x43, 0x44, 0x55, 0x42, 0x51, 0x67, 0x46, 0x38, 0x4D,
-		0x67, 0x51, 0x68, 0x42, 0x68, 0x4A, 0x45, 0x4E, 0x77, 0x45, 0x63, 0x41,
-		0x54, 0x45, 0x41, 0x45, 0x30, 0x51, 0x33, 0x41, 0x52, 0x77, 0x42, 0x4D,
-		0x77, 0x51, 0x55, 0x45, 0x6B, 0x51, 0x7A, 0x41, 0x67, 0x41, 0x78, 0x41,
-		0x42, 0x4E, 0x45, 0x4D, 0x77, 0x49, 0x55, 0x4D, 0x51, 0x41, 0x53, 0x52,
-		0x44, 0x4D, 0x44, 0x41, 0x44, 0x4D, 0x43, 0x41, 0x42, 0x4A, 0x45, 0x4D,
-		0x77, 0x49, 0x52, 0x4A, 0x52, 0x4A, 0x45, 0x4D, 0x77, 0x4D, 0x55, 0x4D,
-		0x77, 0x4D, 0x48, 0x4D, 0x77, 0x4D, 0x51, 0x49, 0x68, 0x4A, 0x4E, 0x4D,
-		0x51, 0x41, 0x53, 0x52, 0x44, 0x4D, 0x44, 0x45, 0x53, 0x4D, 0x7A, 0x41,
-		0x78, 0x41, 0x69, 0x45, 0x6B, 0x30, 0x6B, 0x45, 0x6B, 0x51, 0x7A, 0x42,
-		0x41, 0x41, 0x78, 0x41, 0x42, 0x4A, 0x45, 0x4D, 0x77, 0x51, 0x55, 0x4D,
-		0x77, 0x49, 0x41, 0x45, 0x6B, 0x51, 0x7A, 0x41, 0x51, 0x45, 0x7A, 0x42,
-		0x41, 0x45, 0x49, 0x4E, 0x51, 0x46, 0x43, 0x41, 0x52, 0x45, 0x79, 0x42,
-		0x43, 0x45, 0x47, 0x45, 0x6B, 0x51, 0x33, 0x41, 0x52, 0x77, 0x42, 0x4D,
-		0x51, 0x41, 0x54, 0x52, 0x44, 0x63, 0x42, 0x48, 0x41, 0x45, 0x7A, 0x41,
-		0x68, 0x51, 0x53, 0x52, 0x44, 0x4D, 0x44, 0x46, 0x44, 0x4D, 0x44, 0x42,
-		0x7A, 0x4D, 0x44, 0x45, 0x43, 0x49, 0x53, 0x54, 0x54, 0x63, 0x42, 0x48,
-		0x41, 0x45, 0x53, 0x52, 0x44, 0x4D, 0x43, 0x41, 0x44, 0x45, 0x41, 0x45,
-		0x6B, 0x51, 0x7A, 0x41, 0x68, 0x51, 0x7A, 0x42, 0x41, 0x41, 0x53, 0x52,
-		0x44, 0x4D, 0x43, 0x45, 0x53, 0x55, 0x53, 0x52, 0x44, 0x4D, 0x44, 0x41,
-		0x44, 0x45, 0x41, 0x45, 0x6B, 0x51, 0x7A, 0x41, 0x78, 0x51, 0x7A, 0x41,
-		0x77, 0x63, 0x7A, 0x41, 0x78, 0x41, 0x69, 0x45, 0x6B, 0x30, 0x7A, 0x42,
-		0x41, 0x41, 0x53, 0x52, 0x44, 0x4D, 0x44, 0x45, 0x53, 0x4D, 0x7A, 0x41,
-		0x78, 0x41, 0x69, 0x45, 0x6B, 0x30, 0x6B, 0x45, 0x6B, 0x51, 0x7A, 0x42,
-		0x41, 0x41, 0x78, 0x41, 0x42, 0x4E, 0x45, 0x4D, 0x77, 0x51, 0x55, 0x4D,
-		0x51, 0x41, 0x53, 0x52, 0x44, 0x4D, 0x42, 0x41, 0x54, 0x4D, 0x43, 0x41,
-		0x51, 0x67, 0x7A, 0x41, 0x77, 0x45, 0x49, 0x4E, 0x51, 0x46, 0x43, 0x41,
-		0x4A, 0x41, 0x79, 0x42, 0x43, 0x45, 0x46, 0x45, 0x6B, 0x51, 0x33, 0x41,
-		0x52, 0x77, 0x42, 0x4D, 0x51, 0x41, 0x54, 0x52, 0x44, 0x4D, 0x43, 0x41,
-		0x44, 0x63, 0x42, 0x48, 0x41, 0x45, 0x53, 0x52, 0x44, 0x4D, 0x43, 0x41,
-		0x44, 0x45, 0x41, 0x45, 0x30, 0x51, 0x7A, 0x41, 0x77, 0x41, 0x78, 0x41,
-		0x42, 0x4A, 0x45, 0x4D, 0x77, 0x49, 0x55, 0x4D, 0x77, 0x49, 0x48, 0x4D,
-		0x77, 0x49, 0x51, 0x49, 0x68, 0x4A, 0x4E, 0x4D, 0x51, 0x41, 0x53, 0x52,
-		0x44, 0x4D, 0x44, 0x46, 0x44, 0x4D, 0x44, 0x42, 0x7A, 0x4D, 0x44, 0x45,
-		0x43, 0x49, 0x53, 0x54, 0x54, 0x4D, 0x43, 0x41, 0x42, 0x4A, 0x45, 0x4D,
-		0x77, 0x45, 0x42, 0x4D, 0x77, 0x4D, 0x42, 0x43, 0x44, 0x55, 0x42, 0x51,
-		0x67, 0x41, 0x2B, 0x4D, 0x67, 0x51, 0x68, 0x42, 0x42, 0x4A, 0x45, 0x4E,
-		0x77, 0x45, 0x63, 0x41, 0x54, 0x45, 0x41, 0x45, 0x30, 0x51, 0x7A, 0x41,
-		0x68, 0x51, 0x7A, 0x41, 0x67, 0x63, 0x7A, 0x41, 0x68, 0x41, 0x69, 0x45,
-		0x6B, 0x30, 0x33, 0x41, 0x52, 0x77, 0x42, 0x45, 0x6B, 0x51, 0x7A, 0x41,
-		0x51, 0x45, 0x7A, 0x41, 0x67, 0x45, 0x49, 0x4E, 0x51, 0x46, 0x43, 0x41,
-		0x42, 0x49, 0x79, 0x42, 0x43, 0x45, 0x45, 0x45, 0x6B, 0x51, 0x7A, 0x41,
-		0x51, 0x45, 0x7A, 0x41, 0x67, 0x45, 0x49, 0x4E, 0x51, 0x46, 0x43, 0x41,
-		0x41, 0x41, 0x7A, 0x41, 0x41, 0x41, 0x78, 0x41, 0x42, 0x4E, 0x45, 0x4D,
-		0x77, 0x41, 0x48, 0x4D, 0x51, 0x41, 0x53, 0x52, 0x44, 0x4D, 0x41, 0x43,
-		0x44, 0x51, 0x42, 0x44, 0x30, 0x4D, 0x3D, 0x22, 0x2C, 0x0A, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x61, 0x64, 0x64, 0x72, 0x65,
-		0x73, 0x73, 0x22, 0x3A, 0x20, 0x22, 0x41, 0x42, 0x55, 0x4B, 0x41, 0x58,
-		0x54, 0x41, 0x4E, 0x57, 0x52, 0x36, 0x4B, 0x36, 0x5A, 0x59, 0x56, 0x37,
-		0x35, 0x44, 0x57, 0x4A, 0x45, 0x50, 0x56, 0x57, 0x57, 0x4F, 0x55, 0x36,
-		0x53, 0x46, 0x55, 0x56, 0x52, 0x49, 0x36, 0x51, 0x48, 0x4F, 0x34, 0x34,
-		0x45, 0x34, 0x53, 0x49, 0x44, 0x4C, 0x48, 0x42, 0x54, 0x44, 0x32, 0x43,
-		0x5A, 0x36, 0x34, 0x41, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x22, 0x73, 0x69, 0x7A, 0x65, 0x22, 0x3A, 0x20, 0x38,
-		0x38, 0x31, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-		0x22, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6C, 0x65, 0x73, 0x22, 0x3A,
-		0x20, 0x5B, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x7B, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x22, 0x6E, 0x61, 0x6D, 0x65, 0x22, 0x3A, 0x20, 0x22,
-		0x54, 0x4D, 0x50, 0x4C, 0x5F, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5F, 0x49,
-		0x44, 0x5F, 0x31, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22,
-		0x3A, 0x20, 0x22, 0x69, 0x6E, 0x74, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6E,
-		0x64, 0x65, 0x78, 0x22, 0x3A, 0x20, 0x31, 0x35, 0x2C, 0x0A, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6C,
-		0x65, 0x6E, 0x67, 0x74, 0x68, 0x22, 0x3A, 0x20, 0x31, 0x30, 0x0A, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7D, 0x2C, 0x0A,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7B, 0x0A,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-		0x22, 0x6E, 0x61, 0x6D, 0x65, 0x22, 0x3A, 0x20, 0x22, 0x54, 0x4D, 0x50,
-		0x4C, 0x5F, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5F, 0x49, 0x44, 0x5F, 0x32,
-		0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3A, 0x20, 0x22,
-		0x69, 0x6E, 0x74, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6E, 0x64, 0x65, 0x78,
-		0x22, 0x3A, 0x20, 0x35, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6C, 0x65, 0x6E, 0x67, 0x74,
-		0x68, 0x22, 0x3A, 0x20, 0x31, 0x30, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x7D, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7B, 0x0A, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6E, 0x61, 0x6D,
-		0x65, 0x22, 0x3A, 0x20, 0x22, 0x54, 0x4D, 0x50, 0x4C, 0x5F, 0x56, 0x41,
-		0x4C, 0x49, 0x44, 0x41, 0x54, 0x4F, 0x52, 0x5F, 0x41, 0x50, 0x50, 0x5F,
-		0x49, 0x44, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3A,
-		0x20, 0x22, 0x69, 0x6E, 0x74, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69, 0x6E, 0x64,
-		0x65, 0x78, 0x22, 0x3A, 0x20, 0x37, 0x34, 0x2C, 0x0A, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6C, 0x65,
-		0x6E, 0x67, 0x74, 0x68, 0x22, 0x3A, 0x20, 0x31, 0x30, 0x0A, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7D, 0x0A, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5D, 0x2C, 0x0A, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x6F, 0x75, 0x72, 0x63, 0x65,
-		0x22, 0x3A, 0x20, 0x22, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3A, 0x2F, 0x2F,
-		0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2E, 0x63, 0x6F, 0x6D, 0x2F, 0x74,
-		0x69, 0x6E, 0x79, 0x6D, 0x61, 0x6E, 0x6F, 0x72, 0x67, 0x2F, 0x74, 0x69,
-		0x6E, 0x79, 0x6D, 0x61, 0x6E, 0x2D, 0x63, 0x6F, 0x6E, 0x74, 0x72, 0x61,
-		0x63, 0x74, 0x73, 0x2D, 0x76, 0x31, 0x2F, 0x74, 0x72, 0x65, 0x65, 0x2F,
-		0x64, 0x63, 0x39, 0x61, 0x62, 0x34, 0x30, 0x63, 0x35, 0x38, 0x62, 0x38,
-		0x35, 0x63, 0x31, 0x35, 0x64, 0x35, 0x38, 0x66, 0x36, 0x33, 0x61, 0x31,
-		0x35, 0x30, 0x37, 0x65, 0x31, 0x38, 0x62, 0x65, 0x37, 0x36, 0x37, 0x32,
-		0x30, 0x64, 0x62, 0x62, 0x2F, 0x63, 0x6F, 0x6E, 0x74, 0x72, 0x61, 0x63,
-		0x74, 0x73, 0x2F, 0x70, 0x6F, 0x6F, 0x6C, 0x5F, 0x6C, 0x6F, 0x67, 0x69,
-		0x63, 0x73, 0x69, 0x67, 0x2E, 0x74, 0x65, 0x61, 0x6C, 0x2E, 0x74, 0x6D,
-		0x70, 0x6C, 0x22, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7D, 0x2C,
-		0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6E, 0x61, 0x6D, 0x65,
-		0x22, 0x3A, 0x20, 0x22, 0x70, 0x6F, 0x6F, 0x6C, 0x5F, 0x6C, 0x6F, 0x67,
-		0x69, 0x63, 0x73, 0x69, 0x67, 0x22, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x7D,
-		0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6C, 0x69, 0x64,
-		0x61, 0x74, 0x6F, 0x72, 0x5F, 0x61, 0x70, 0x70, 0x22, 0x3A, 0x20, 0x7B,
-		0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65,
-		0x22, 0x3A, 0x20, 0x22, 0x61, 0x70, 0x70, 0x22, 0x2C, 0x0A, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x22, 0x61, 0x70, 0x70, 0x72, 0x6F, 0x76, 0x61,
-		0x6C, 0x5F, 0x70, 0x72, 0x6F, 0x67, 0x72, 0x61, 0x6D, 0x22, 0x3A, 0x20,
-		0x7B, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x62,
-		0x79, 0x74, 0x65, 0x63, 0x6F, 0x64, 0x65, 0x22, 0x3A, 0x20, 0x22, 0x42,
-		0x43, 0x41, 0x48, 0x41, 0x41, 0x48, 0x6F, 0x42, 0x2B, 0x55, 0x48, 0x42,
-		0x66, 0x2F, 0x2F, 0x2F, 0x2F, 0x2F, 0x2F, 0x2F, 0x2F, 0x2F, 0x2F, 0x2F,
-		0x77, 0x48, 0x41, 0x68, 0x44, 0x30, 0x6D, 0x44, 0x51, 0x46, 0x76, 0x41,
-		0x57, 0x55, 0x42, 0x63, 0x41, 0x4A, 0x68, 0x4D, 0x51, 0x4A, 0x68, 0x4D,
-		0x67, 0x4A, 0x73, 0x64, 0x41, 0x52, 0x7A, 0x64, 0x32, 0x46, 0x77, 0x42,
-		0x47, 0x31, 0x70, 0x62, 0x6E, 0x51, 0x42, 0x64, 0x41, 0x4A, 0x6A, 0x4D,
-		0x51, 0x4A, 0x77, 0x4D, 0x51, 0x4A, 0x6A, 0x4D, 0x67, 0x4A, 0x77, 0x4D,
-		0x6A, 0x45, 0x5A, 0x67, 0x51, 0x51, 0x53, 0x4D, 0x52, 0x6B, 0x68, 0x42,
-		0x42, 0x49, 0x52, 0x4D, 0x52, 0x6D, 0x42, 0x41, 0x68, 0x49, 0x52, 0x51,
-		0x41, 0x54, 0x78, 0x4D, 0x52, 0x6B, 0x6A, 0x45, 0x6A, 0x45, 0x62, 0x49,
-		0x68, 0x49, 0x51, 0x51, 0x41, 0x54, 0x6A, 0x4E, 0x68, 0x6F, 0x41, 0x67,
-		0x41, 0x5A, 0x6A, 0x63, 0x6D, 0x56, 0x68, 0x64, 0x47, 0x55, 0x53, 0x51,
-		0x41, 0x54, 0x55, 0x4D, 0x52, 0x6B, 0x6A, 0x45, 0x6A, 0x59, 0x61, 0x41,
-		0x49, 0x41, 0x4A, 0x59, 0x6D, 0x39, 0x76, 0x64, 0x48, 0x4E, 0x30, 0x63,
-		0x6D, 0x46, 0x77, 0x45, 0x68, 0x42, 0x41, 0x41, 0x2F, 0x4D, 0x7A, 0x41,
-		0x68, 0x49, 0x7A, 0x41, 0x67, 0x67, 0x49, 0x4E, 0x54, 0x51, 0x69, 0x4B,
-		0x32, 0x49, 0x31, 0x5A, 0x53, 0x49, 0x30, 0x5A, 0x58, 0x41, 0x41, 0x52,
-		0x44, 0x55, 0x42, 0x49, 0x69, 0x63, 0x45, 0x59, 0x6A, 0x56, 0x6D, 0x4E,
-		0x47, 0x5A, 0x41, 0x41, 0x42, 0x45, 0x69, 0x59, 0x43, 0x4A, 0x34, 0x43,
-		0x54, 0x45, 0x42, 0x43, 0x44, 0x4D, 0x41, 0x43, 0x41, 0x6B, 0x31, 0x41,
-		0x6B, 0x49, 0x41, 0x43, 0x43, 0x49, 0x30, 0x5A, 0x6E, 0x41, 0x41, 0x52,
-		0x44, 0x55, 0x43, 0x49, 0x69, 0x63, 0x46, 0x59, 0x6A, 0x56, 0x6E, 0x4B,
-		0x44, 0x52, 0x6C, 0x46, 0x6C, 0x41, 0x31, 0x62, 0x79, 0x49, 0x30, 0x62,
-		0x32, 0x49, 0x31, 0x50, 0x53, 0x67, 0x30, 0x5A, 0x68, 0x5A, 0x51, 0x4E,
-		0x58, 0x41, 0x69, 0x4E, 0x48, 0x42, 0x69, 0x4E, 0x54, 0x34, 0x6F, 0x4E,
-		0x47, 0x63, 0x57, 0x55, 0x44, 0x56, 0x78, 0x49, 0x6A, 0x52, 0x78, 0x59,
-		0x6A, 0x55, 0x2F, 0x49, 0x69, 0x70, 0x69, 0x4E, 0x55, 0x41, 0x30, 0x41,
-		0x54, 0x51, 0x39, 0x43, 0x54, 0x56, 0x48, 0x4E, 0x41, 0x49, 0x30, 0x50,
-		0x67, 0x6B, 0x31, 0x53, 0x44, 0x45, 0x41, 0x4B, 0x56, 0x41, 0x30, 0x5A,
-		0x52, 0x5A, 0x51, 0x4E, 0x58, 0x6B, 0x78, 0x41, 0x43, 0x6C, 0x51, 0x4E,
-		0x47, 0x59, 0x57, 0x55, 0x44, 0x56, 0x36, 0x4D, 0x51, 0x41, 0x70, 0x55,
-		0x44, 0x52, 0x6E, 0x46, 0x6C, 0x41, 0x31, 0x65, 0x7A, 0x59, 0x61, 0x41,
-		0x49, 0x41, 0x47, 0x63, 0x6D, 0x56, 0x6B, 0x5A, 0x57, 0x56, 0x74, 0x45,
-		0x6B, 0x41, 0x41, 0x57, 0x6A, 0x59, 0x61, 0x41, 0x49, 0x41, 0x45, 0x5A,
-		0x6D, 0x56, 0x6C, 0x63, 0x78, 0x4A, 0x41, 0x41, 0x42, 0x77, 0x32, 0x47,
-		0x67, 0x41, 0x6E, 0x42, 0x68, 0x49, 0x32, 0x47, 0x67, 0x41, 0x6E, 0x42,
-		0x78, 0x49, 0x52, 0x4E, 0x68, 0x6F, 0x41, 0x67, 0x41, 0x52, 0x69, 0x64,
-		0x58, 0x4A, 0x75, 0x45, 0x68, 0x46, 0x41, 0x41, 0x47, 0x30, 0x41, 0x4E,
-		0x47, 0x64, 0x4A, 0x52, 0x44, 0x4D, 0x43, 0x45, 0x52, 0x4A, 0x45, 0x4D,
-		0x77, 0x49, 0x53, 0x52, 0x44, 0x4D, 0x43, 0x46, 0x44, 0x49, 0x4A, 0x45,
-		0x6B, 0x51, 0x30, 0x50, 0x7A, 0x4D, 0x43, 0x45, 0x67, 0x6B, 0x31, 0x50,
-		0x7A, 0x52, 0x41, 0x4D, 0x77, 0x49, 0x53, 0x43, 0x54, 0x56, 0x41, 0x49,
-		0x69, 0x6F, 0x30, 0x51, 0x47, 0x59, 0x69, 0x4E, 0x48, 0x45, 0x30, 0x50,
-		0x32, 0x59, 0x6A, 0x51, 0x7A, 0x4D, 0x43, 0x46, 0x44, 0x4D, 0x43, 0x42,
-		0x7A, 0x4D, 0x43, 0x45, 0x43, 0x4D, 0x53, 0x54, 0x54, 0x59, 0x63, 0x41,
-		0x52, 0x4A, 0x45, 0x4E, 0x44, 0x52, 0x45, 0x49, 0x69, 0x67, 0x7A, 0x41,
-		0x68, 0x45, 0x57, 0x55, 0x45, 0x70, 0x69, 0x4E, 0x44, 0x51, 0x4A, 0x5A,
-		0x69, 0x4D, 0x78, 0x41, 0x43, 0x6C, 0x51, 0x4D, 0x77, 0x49, 0x52, 0x46,
-		0x6C, 0x42, 0x4B, 0x59, 0x6A, 0x51, 0x30, 0x43, 0x55, 0x6C, 0x42, 0x41,
-		0x41, 0x4E, 0x6D, 0x49, 0x30, 0x4E, 0x49, 0x61, 0x43, 0x4E, 0x44, 0x4D,
-		0x67, 0x63, 0x69, 0x4A, 0x77, 0x68, 0x69, 0x43, 0x55, 0x6B, 0x31, 0x2B,
-		0x6B, 0x45, 0x41, 0x52, 0x69, 0x49, 0x6E, 0x43, 0x57, 0x49, 0x69, 0x4A,
-		0x77, 0x70, 0x69, 0x4E, 0x50, 0x6F, 0x64, 0x54, 0x45, 0x41, 0x41, 0x4E,
-		0x78, 0x34, 0x68, 0x42, 0x53, 0x4D, 0x65, 0x48, 0x7A, 0x58, 0x37, 0x53,
-		0x45, 0x68, 0x49, 0x49, 0x69, 0x63, 0x4C, 0x59, 0x69, 0x49, 0x6E, 0x44,
-		0x47, 0x49, 0x30, 0x2B, 0x68, 0x31, 0x4D, 0x51, 0x41, 0x41, 0x64, 0x48,
-		0x69, 0x45, 0x46, 0x49, 0x78, 0x34, 0x66, 0x4E, 0x66, 0x78, 0x49, 0x53,
-		0x45, 0x67, 0x69, 0x4A, 0x77, 0x6B, 0x30, 0x2B, 0x32, 0x59, 0x69, 0x4A,
-		0x77, 0x73, 0x30, 0x2F, 0x47, 0x59, 0x69, 0x4A, 0x77, 0x67, 0x79, 0x42,
-		0x32, 0x59, 0x7A, 0x41, 0x78, 0x49, 0x7A, 0x41, 0x77, 0x67, 0x49, 0x4E,
-		0x54, 0x55, 0x32, 0x48, 0x41, 0x45, 0x78, 0x41, 0x42, 0x4E, 0x45, 0x4E,
-		0x47, 0x64, 0x42, 0x41, 0x43, 0x49, 0x69, 0x4E, 0x47, 0x64, 0x77, 0x41,
-		0x45, 0x51, 0x31, 0x42, 0x69, 0x49, 0x63, 0x4E, 0x41, 0x59, 0x4A, 0x4E,
-		0x44, 0x38, 0x49, 0x4E, 0x51, 0x51, 0x32, 0x47, 0x67, 0x41, 0x6E, 0x42,
-		0x68, 0x4A, 0x41, 0x41, 0x53, 0x41, 0x30, 0x5A, 0x7A, 0x4D, 0x45, 0x45,
-		0x52, 0x4A, 0x45, 0x4E, 0x68, 0x6F, 0x41, 0x4A, 0x77, 0x63, 0x53, 0x51,
-		0x41, 0x42, 0x56, 0x4E, 0x68, 0x77, 0x42, 0x4D, 0x77, 0x51, 0x41, 0x45,
-		0x6B, 0x51, 0x7A, 0x42, 0x42, 0x49, 0x30, 0x52, 0x78, 0x30, 0x30, 0x42,
-		0x43, 0x4D, 0x64, 0x48, 0x30, 0x68, 0x49, 0x54, 0x45, 0x68, 0x4A, 0x4E,
-		0x52, 0x41, 0x30, 0x4E, 0x41, 0x6B, 0x31, 0x79, 0x54, 0x4D, 0x45, 0x45,
-		0x6A, 0x52, 0x49, 0x48, 0x54, 0x51, 0x45, 0x49, 0x78, 0x30, 0x66, 0x53,
-		0x45, 0x68, 0x4D, 0x53, 0x45, 0x6B, 0x31, 0x45, 0x54, 0x51, 0x31, 0x43,
-		0x54, 0x58, 0x4B, 0x4E, 0x42, 0x41, 0x30, 0x45, 0x52, 0x42, 0x45, 0x4E,
-		0x45, 0x63, 0x30, 0x45, 0x41, 0x6B, 0x31, 0x55, 0x54, 0x52, 0x49, 0x4E,
-		0x42, 0x45, 0x4A, 0x4E, 0x56, 0x49, 0x30, 0x42, 0x44, 0x4D, 0x45, 0x45,
-		0x67, 0x6B, 0x31, 0x55, 0x30, 0x49, 0x43, 0x43, 0x6A, 0x59, 0x63, 0x41,
-		0x54, 0x4D, 0x43, 0x41, 0x42, 0x4A, 0x45, 0x4E, 0x45, 0x63, 0x30, 0x4E,
-		0x41, 0x67, 0x31, 0x55, 0x54, 0x52, 0x49, 0x4E, 0x44, 0x55, 0x49, 0x4E,
-		0x56, 0x49, 0x30, 0x42, 0x43, 0x49, 0x53, 0x51, 0x41, 0x41, 0x75, 0x4E,
-		0x44, 0x51, 0x30, 0x42, 0x42, 0x30, 0x30, 0x52, 0x79, 0x4D, 0x64, 0x48,
-		0x30, 0x68, 0x49, 0x54, 0x45, 0x67, 0x30, 0x4E, 0x54, 0x51, 0x45, 0x48,
-		0x54, 0x52, 0x49, 0x49, 0x78, 0x30, 0x66, 0x53, 0x45, 0x68, 0x4D, 0x53,
-		0x45, 0x6F, 0x4E, 0x54, 0x55, 0x6B, 0x30, 0x42, 0x41, 0x67, 0x31, 0x55,
-		0x7A, 0x4D, 0x45, 0x45, 0x67, 0x6B, 0x31, 0x79, 0x30, 0x49, 0x42, 0x76,
-		0x79, 0x49, 0x6E, 0x42, 0x54, 0x4D, 0x45, 0x45, 0x55, 0x6B, 0x31, 0x5A,
-		0x32, 0x59, 0x6F, 0x4E, 0x47, 0x63, 0x57, 0x55, 0x44, 0x56, 0x78, 0x49,
-		0x6A, 0x52, 0x6E, 0x63, 0x41, 0x42, 0x45, 0x52, 0x44, 0x52, 0x6E, 0x4E,
-		0x47, 0x55, 0x54, 0x52, 0x44, 0x52, 0x6E, 0x4E, 0x47, 0x59, 0x54, 0x52,
-		0x44, 0x4D, 0x45, 0x45, 0x69, 0x51, 0x49, 0x53, 0x52, 0x30, 0x31, 0x38,
-		0x44, 0x51, 0x30, 0x4E, 0x44, 0x55, 0x64, 0x4E, 0x66, 0x46, 0x4B, 0x44,
-		0x45, 0x41, 0x41, 0x43, 0x42, 0x4A, 0x45, 0x4E, 0x50, 0x41, 0x30, 0x38,
-		0x51, 0x35, 0x45, 0x4D, 0x77, 0x51, 0x53, 0x4A, 0x41, 0x67, 0x6A, 0x43,
-		0x45, 0x6B, 0x64, 0x4E, 0x66, 0x41, 0x30, 0x4E, 0x44, 0x51, 0x31, 0x48,
-		0x54, 0x58, 0x78, 0x53, 0x67, 0x31, 0x41, 0x41, 0x41, 0x67, 0x53, 0x52,
-		0x44, 0x54, 0x77, 0x4E, 0x50, 0x45, 0x4E, 0x52, 0x43, 0x51, 0x31, 0x50,
-		0x7A, 0x51, 0x45, 0x4D, 0x77, 0x51, 0x53, 0x4A, 0x41, 0x67, 0x49, 0x4E,
-		0x56, 0x4E, 0x43, 0x41, 0x55, 0x38, 0x32, 0x48, 0x41, 0x45, 0x7A, 0x41,
-		0x67, 0x41, 0x53, 0x52, 0x44, 0x4D, 0x43, 0x45, 0x54, 0x52, 0x6C, 0x45,
-		0x6A, 0x4D, 0x44, 0x45, 0x54, 0x52, 0x6D, 0x45, 0x68, 0x42, 0x4A, 0x4E,
-		0x57, 0x52, 0x41, 0x41, 0x42, 0x6B, 0x7A, 0x41, 0x68, 0x45, 0x30, 0x5A,
-		0x68, 0x49, 0x7A, 0x41, 0x78, 0x45, 0x30, 0x5A, 0x52, 0x49, 0x51, 0x52,
-		0x44, 0x52, 0x49, 0x4E, 0x52, 0x49, 0x30, 0x52, 0x7A, 0x55, 0x54, 0x51,
-		0x67, 0x41, 0x49, 0x4E, 0x45, 0x63, 0x31, 0x45, 0x6A, 0x52, 0x49, 0x4E,
-		0x52, 0x4D, 0x32, 0x47, 0x67, 0x47, 0x41, 0x41, 0x6D, 0x5A, 0x70, 0x45,
-		0x6B, 0x41, 0x41, 0x57, 0x6A, 0x59, 0x61, 0x41, 0x59, 0x41, 0x43, 0x5A,
-		0x6D, 0x38, 0x53, 0x52, 0x44, 0x51, 0x31, 0x4A, 0x41, 0x73, 0x30, 0x45,
-		0x68, 0x30, 0x30, 0x45, 0x7A, 0x51, 0x31, 0x43, 0x53, 0x55, 0x64, 0x48,
-		0x30, 0x68, 0x49, 0x54, 0x45, 0x67, 0x6A, 0x43, 0x45, 0x6B, 0x31, 0x46,
-		0x53, 0x49, 0x4E, 0x4E, 0x44, 0x55, 0x30, 0x45, 0x77, 0x77, 0x51, 0x52,
-		0x44, 0x51, 0x30, 0x4E, 0x42, 0x55, 0x4A, 0x4E, 0x47, 0x52, 0x42, 0x41,
-		0x42, 0x4D, 0x31, 0x79, 0x54, 0x52, 0x48, 0x4E, 0x42, 0x55, 0x49, 0x4E,
-		0x56, 0x45, 0x30, 0x53, 0x44, 0x51, 0x31, 0x43, 0x54, 0x56, 0x53, 0x51,
-		0x67, 0x42, 0x6E, 0x4E, 0x63, 0x6F, 0x30, 0x53, 0x44, 0x51, 0x56, 0x43,
-		0x44, 0x56, 0x53, 0x4E, 0x45, 0x63, 0x30, 0x4E, 0x51, 0x6B, 0x31, 0x55,
-		0x55, 0x49, 0x41, 0x56, 0x44, 0x51, 0x30, 0x53, 0x54, 0x55, 0x56, 0x4A,
-		0x51, 0x73, 0x30, 0x45, 0x78, 0x30, 0x30, 0x45, 0x69, 0x51, 0x4C, 0x4E,
-		0x44, 0x51, 0x6C, 0x43, 0x78, 0x34, 0x66, 0x53, 0x45, 0x68, 0x4D, 0x53,
-		0x45, 0x6B, 0x31, 0x46, 0x43, 0x49, 0x4E, 0x4E, 0x42, 0x51, 0x30, 0x45,
-		0x77, 0x77, 0x51, 0x52, 0x44, 0x51, 0x55, 0x4E, 0x44, 0x55, 0x4A, 0x4E,
-		0x47, 0x52, 0x42, 0x41, 0x42, 0x4D, 0x31, 0x79, 0x6A, 0x52, 0x48, 0x4E,
-		0x44, 0x51, 0x49, 0x4E, 0x56, 0x45, 0x30, 0x53, 0x44, 0x51, 0x55, 0x43,
-		0x54, 0x56, 0x53, 0x51, 0x67, 0x41, 0x54, 0x4E, 0x63, 0x6B, 0x30, 0x52,
-		0x7A, 0x51, 0x55, 0x43, 0x54, 0x56, 0x52, 0x4E, 0x45, 0x67, 0x30, 0x4E,
-		0x41, 0x67, 0x31, 0x55, 0x6B, 0x49, 0x41, 0x41, 0x44, 0x51, 0x56, 0x49,
-		0x51, 0x51, 0x4C, 0x4E, 0x41, 0x51, 0x64, 0x67, 0x61, 0x43, 0x63, 0x41,
-		0x54, 0x51, 0x53, 0x48, 0x52, 0x39, 0x49, 0x53, 0x45, 0x78, 0x49, 0x53,
-		0x54, 0x55, 0x71, 0x4E, 0x41, 0x51, 0x49, 0x4E, 0x56, 0x4E, 0x43, 0x41,
-		0x44, 0x73, 0x69, 0x4B, 0x7A, 0x59, 0x61, 0x41, 0x52, 0x64, 0x4A, 0x4E,
-		0x57, 0x56, 0x6D, 0x49, 0x69, 0x63, 0x45, 0x4E, 0x68, 0x6F, 0x43, 0x46,
-		0x30, 0x6B, 0x31, 0x5A, 0x6D, 0x59, 0x30, 0x5A, 0x58, 0x45, 0x44, 0x52,
-		0x49, 0x41, 0x42, 0x4C, 0x56, 0x43, 0x41, 0x42, 0x45, 0x46, 0x4D, 0x52,
-		0x30, 0x38, 0x30, 0x5A, 0x6B, 0x45, 0x41, 0x42, 0x6B, 0x67, 0x30, 0x5A,
-		0x6E, 0x45, 0x44, 0x52, 0x46, 0x41, 0x7A, 0x41, 0x69, 0x5A, 0x4A, 0x46,
-		0x59, 0x45, 0x50, 0x54, 0x46, 0x49, 0x53, 0x51, 0x79, 0x49, 0x71, 0x4E,
-		0x45, 0x41, 0x30, 0x4B, 0x67, 0x68, 0x6D, 0x49, 0x6A, 0x52, 0x78, 0x4E,
-		0x44, 0x38, 0x30, 0x4B, 0x67, 0x67, 0x30, 0x79, 0x77, 0x68, 0x6D, 0x49,
-		0x6A, 0x52, 0x76, 0x4E, 0x44, 0x30, 0x30, 0x79, 0x51, 0x68, 0x6D, 0x49,
-		0x6A, 0x52, 0x77, 0x4E, 0x44, 0x34, 0x30, 0x79, 0x67, 0x68, 0x6D, 0x49,
-		0x6F, 0x41, 0x43, 0x63, 0x7A, 0x45, 0x30, 0x55, 0x57, 0x59, 0x69, 0x67,
-		0x41, 0x4A, 0x7A, 0x4D, 0x6A, 0x52, 0x53, 0x5A, 0x69, 0x49, 0x6E, 0x43,
-		0x6A, 0x52, 0x53, 0x49, 0x51, 0x59, 0x64, 0x4E, 0x46, 0x45, 0x6A, 0x48,
-		0x52, 0x39, 0x49, 0x53, 0x45, 0x78, 0x49, 0x5A, 0x69, 0x49, 0x6E, 0x44,
-		0x44, 0x52, 0x52, 0x49, 0x51, 0x59, 0x64, 0x4E, 0x46, 0x49, 0x6A, 0x48,
-		0x52, 0x39, 0x49, 0x53, 0x45, 0x78, 0x49, 0x5A, 0x69, 0x4B, 0x41, 0x41,
-		0x32, 0x6C, 0x73, 0x64, 0x44, 0x52, 0x54, 0x5A, 0x6A, 0x54, 0x4C, 0x51,
-		0x51, 0x41, 0x4A, 0x49, 0x7A, 0x52, 0x37, 0x53, 0x6D, 0x49, 0x30, 0x79,
-		0x77, 0x68, 0x6D, 0x4E, 0x4D, 0x6C, 0x42, 0x41, 0x41, 0x6B, 0x6A, 0x4E,
-		0x48, 0x6C, 0x4B, 0x59, 0x6A, 0x54, 0x4A, 0x43, 0x47, 0x59, 0x30, 0x79,
-		0x6B, 0x45, 0x41, 0x43, 0x53, 0x4D, 0x30, 0x65, 0x6B, 0x70, 0x69, 0x4E,
-		0x4D, 0x6F, 0x49, 0x5A, 0x69, 0x4E, 0x44, 0x49, 0x30, 0x4D, 0x69, 0x51,
-		0x77, 0x3D, 0x3D, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x22, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3A,
-		0x20, 0x22, 0x42, 0x55, 0x51, 0x48, 0x58, 0x48, 0x50, 0x4C, 0x4D, 0x59,
-		0x55, 0x56, 0x53, 0x33, 0x50, 0x32, 0x49, 0x4E, 0x4A, 0x32, 0x45, 0x55,
-		0x4A, 0x46, 0x43, 0x53, 0x4E, 0x54, 0x36, 0x4C, 0x4E, 0x55, 0x47, 0x58,
-		0x56, 0x4D, 0x36, 0x54, 0x32, 0x53, 0x5A, 0x32, 0x37, 0x54, 0x44, 0x52,
-		0x44, 0x59, 0x4C, 0x55, 0x4D, 0x57, 0x43, 0x46, 0x59, 0x57, 0x33, 0x45,
-		0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
-		0x73, 0x69, 0x7A, 0x65, 0x22, 0x3A, 0x20, 0x31, 0x33, 0x35, 0x31, 0x2C,
-		0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
-		0x72, 0x69, 0x61, 0x62, 0x6C, 0x65, 0x73, 0x22, 0x3A, 0x20, 0x5B, 0x5D,
-		0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73,
-		0x6F, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3A, 0x20, 0x22, 0x68, 0x74, 0x74,
-		0x70, 0x73, 0x3A, 0x2F, 0x2F, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2E,
-		0x63, 0x6F, 0x6D, 0x2F, 0x74, 0x69, 0x6E, 0x79, 0x6D, 0x61, 0x6E, 0x6F,
-		0x72, 0x67, 0x2F, 0x74, 0x69, 0x6E, 0x79, 0x6D, 0x61, 0x6E, 0x2D, 0x63,
-		0x6F, 0x6E, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x2D, 0x76, 0x31, 0x2F,
-		0x74, 0x72, 0x65, 0x65, 0x2F, 0x64, 0x63, 0x39, 0x61, 0x62, 0x34, 0x30,
-		0x63, 0x35, 0x38, 0x62, 0x38, 0x35, 0x63, 0x31, 0x35, 0x64, 0x35, 0x38,
-		0x66, 0x36, 0x33, 0x61, 0x31, 0x35, 0x30, 0x37, 0x65, 0x31, 0x38, 0x62,
-		0x65, 0x37, 0x36, 0x37, 0x32, 0x30, 0x64, 0x62, 0x62, 0x2F, 0x63, 0x6F,
-		0x6E, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x2F, 0x76, 0x61, 0x6C, 0x69,
-		0x64, 0x61, 0x74, 0x6F, 0x72, 0x5F, 0x61, 0x70, 0x70, 0x72, 0x6F, 0x76,
-		0x61, 0x6C, 0x2E, 0x74, 0x65, 0x61, 0x6C, 0x22, 0x0A, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x7D, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-		0x22, 0x63, 0x6C, 0x65, 0x61, 0x72, 0x5F, 0x70, 0x72, 0x6F, 0x67, 0x72,
-		0x61, 0x6D, 0x22, 0x3A, 0x20, 0x7B, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x22, 0x62, 0x79, 0x74, 0x65, 0x63, 0x6F, 0x64, 0x65,
-		0x22, 0x3A, 0x20, 0x22, 0x42, 0x49, 0x45, 0x42, 0x22, 0x2C, 0x0A, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x61, 0x64, 0x64, 0x72,
-		0x65, 0x73, 0x73, 0x22, 0x3A, 0x20, 0x22, 0x50, 0x37, 0x47, 0x45, 0x57,
-		0x44, 0x58, 0x58, 0x57, 0x35, 0x49, 0x4F, 0x4E, 0x52, 0x57, 0x36, 0x58,
-		0x52, 0x49, 0x52, 0x56, 0x50, 0x4A, 0x43, 0x54, 0x32, 0x58, 0x58, 0x45,
-		0x51, 0x47, 0x4F, 0x42, 0x47, 0x47, 0x36, 0x35, 0x56, 0x4A, 0x50, 0x42,
-		0x55, 0x4F, 0x59, 0x5A, 0x45, 0x4A, 0x43, 0x42, 0x5A, 0x57, 0x54, 0x50,
-		0x48, 0x53, 0x33, 0x56, 0x51, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x69, 0x7A, 0x65, 0x22, 0x3A, 0x20,
-		0x33, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
-		0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6C, 0x65, 0x73, 0x22, 0x3A, 0x20,
-		0x5B, 0x5D, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-		0x22, 0x73, 0x6F, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3A, 0x20, 0x22, 0x68,
-		0x74, 0x74, 0x70, 0x73, 0x3A, 0x2F, 0x2F, 0x67, 0x69, 0x74, 0x68, 0x75,
-		0x62, 0x2E, 0x63, 0x6F, 0x6D, 0x2F, 0x74, 0x69, 0x6E, 0x79, 0x6D, 0x61,
-		0x6E, 0x6F, 0x72, 0x67, 0x2F, 0x74, 0x69, 0x6E, 0x79, 0x6D, 0x61, 0x6E,
-		0x2D, 0x63, 0x6F, 0x6E, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x2D, 0x76,
-		0x31, 0x2F, 0x74, 0x72, 0x65, 0x65, 0x2F, 0x64, 0x63, 0x39, 0x61, 0x62,
-		0x34, 0x30, 0x63, 0x35, 0x38, 0x62, 0x38, 0x35, 0x63, 0x31, 0x35, 0x64,
-		0x35, 0x38, 0x66, 0x36, 0x33, 0x61, 0x31, 0x35, 0x30, 0x37, 0x65, 0x31,
-		0x38, 0x62, 0x65, 0x37, 0x36, 0x37, 0x32, 0x30, 0x64, 0x62, 0x62, 0x2F,
-		0x63, 0x6F, 0x6E, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x2F, 0x76, 0x61,
-		0x6C, 0x69, 0x64, 0x61, 0x74, 0x6F, 0x72, 0x5F, 0x63, 0x6C, 0x65, 0x61,
-		0x72, 0x5F, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2E, 0x74, 0x65, 0x61, 0x6C,
-		0x22, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7D, 0x2C, 0x0A, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x67, 0x6C, 0x6F, 0x62, 0x61, 0x6C,
-		0x5F, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5F, 0x73, 0x63, 0x68, 0x65, 0x6D,
-		0x61, 0x22, 0x3A, 0x20, 0x7B, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x22, 0x6E, 0x75, 0x6D, 0x5F, 0x75, 0x69, 0x6E, 0x74, 0x73,
-		0x22, 0x3A, 0x20, 0x30, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x22, 0x6E, 0x75, 0x6D, 0x5F, 0x62, 0x79, 0x74, 0x65, 0x5F,
-		0x73, 0x6C, 0x69, 0x63, 0x65, 0x73, 0x22, 0x3A, 0x20, 0x30, 0x0A, 0x20,
-		0x20, 0x20, 0x20, 0x20, 0x20, 0x7D, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x22, 0x6C, 0x6F, 0x63, 0x61, 0x6C, 0x5F, 0x73, 0x74, 0x61,
-		0x74, 0x65, 0x5F, 0x73, 0x63, 0x68, 0x65, 0x6D, 0x61, 0x22, 0x3A, 0x20,
-		0x7B, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6E,
-		0x75, 0x6D, 0x5F, 0x75, 0x69, 0x6E, 0x74, 0x73, 0x22, 0x3A, 0x20, 0x31,
-		0x36, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
-		0x6E, 0x75, 0x6D, 0x5F, 0x62, 0x79, 0x74, 0x65, 0x5F, 0x73, 0x6C, 0x69,
-		0x63, 0x65, 0x73, 0x22, 0x3A, 0x20, 0x30, 0x0A, 0x20, 0x20, 0x20, 0x20,
-		0x20, 0x20, 0x7D, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
-		0x6E, 0x61, 0x6D, 0x65, 0x22, 0x3A, 0x20, 0x22, 0x76, 0x61, 0x6C, 0x69,
-		0x64, 0x61, 0x74, 0x6F, 0x72, 0x5F, 0x61, 0x70, 0x70, 0x22, 0x0A, 0x20,
-		0x20, 0x20, 0x20, 0x7D, 0x0A, 0x20, 0x20, 0x7D, 0x0A, 0x7D,
-	}
+var bundledASCJson = map[string][]byte{
+	"v1.1": {
+		0x7B, 0x0A, 0x20, 0x20, 0x22, 0x72, 0x65, 0x70, 0x6F, 0x22, 0x3A, 0x20, 0x22, 0x68, 0x74, 0x74,
+		0x70, 0x73, 0x3A, 0x2F, 0x2F, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2E, 0x63, 0x6F, 0x6D, 0x2F,
+		0x74, 0x69, 0x6E, 0x79, 0x6D, 0x61, 0x6E, 0x6F, 0x72, 0x67, 0x2F, 0x74, 0x69, 0x6E, 0x79, 0x6D,
+		0x61, 0x6E, 0x2D, 0x63, 0x6F, 0x6E, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x2D, 0x76, 0x31, 0x22,
+		0x2C, 0x0A, 0x20, 0x20, 0x22, 0x72, 0x65, 0x66, 0x22, 0x3A, 0x20, 0x22, 0x64, 0x63, 0x39, 0x61,
+		0x62, 0x34, 0x30, 0x63, 0x35, 0x38, 0x62, 0x38, 0x35, 0x63, 0x31, 0x35, 0x64, 0x35, 0x38, 0x66,
+		0x36, 0x33, 0x61, 0x31, 0x35, 0x30, 0x37, 0x65, 0x31, 0x38, 0x62, 0x65, 0x37, 0x36, 0x37, 0x32,
+		0x30, 0x64, 0x62, 0x62, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x22, 0x63, 0x6F, 0x6E, 0x74, 0x72, 0x61,
+		0x63, 0x74, 0x73, 0x22, 0x3A, 0x20, 0x7B, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x22, 0x70, 0x6F, 0x6F,
+		0x6C, 0x5F, 0x6C, 0x6F, 0x67, 0x69, 0x63, 0x73, 0x69, 0x67, 0x22, 0x3A, 0x20, 0x7B, 0x0A, 0x20,
+		0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3A, 0x20, 0x22, 0x6C, 0x6F,
+		0x67, 0x69, 0x63, 0x73, 0x69, 0x67, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+		0x6C, 0x6F, 0x67, 0x69, 0x63, 0x22, 0x3A, 0x20, 0x7B, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+		0x20, 0x20, 0x22, 0x62, 0x79, 0x74, 0x65, 0x63, 0x6F, 0x64, 0x65, 0x22, 0x3A, 0x20, 0x22, 0x42,
+		0x43, 0x41, 0x49, 0x41, 0x51, 0x43, 0x42, 0x67, 0x49, 0x43, 0x41, 0x67, 0x49, 0x43, 0x41, 0x67,
+		0x50, 0x41, 0x42, 0x67, 0x49, 0x43, 0x41, 0x67, 0x49, 0x43, 0x41, 0x67, 0x49, 0x44, 0x77, 0x41,
+		0x51, 0x4D, 0x45, 0x42, 0x51, 0x59, 0x6C, 0x4A, 0x41, 0x31, 0x45, 0x4D, 0x51, 0x6B, 0x79, 0x41,
+		0x78, 0x4A, 0x45, 0x4D, 0x52, 0x55, 0x79, 0x41, 0x78, 0x4A, 0x45, 0x4D, 0x53, 0x41, 0x79, 0x41,
+		0x78, 0x4A, 0x45, 0x4D, 0x67, 0x51, 0x69, 0x44, 0x55, 0x51, 0x7A, 0x41, 0x51, 0x41, 0x78, 0x41,
+		0x42, 0x4A, 0x45, 0x4D, 0x77, 0x45, 0x51, 0x49, 0x51, 0x63, 0x53, 0x52, 0x44, 0x4D, 0x42, 0x47,
+		0x49, 0x47, 0x43, 0x67, 0x49, 0x43, 0x41, 0x67, 0x49, 0x43, 0x41, 0x67, 0x50, 0x41, 0x42, 0x45,
+		0x6B, 0x51, 0x7A, 0x41, 0x52, 0x6B, 0x69, 0x45, 0x6A, 0x4D, 0x42, 0x47, 0x79, 0x45, 0x45, 0x45,
+		0x68, 0x41, 0x33, 0x41, 0x52, 0x6F, 0x41, 0x67, 0x41, 0x6C, 0x69, 0x62, 0x32, 0x39, 0x30, 0x63,
+		0x33, 0x52, 0x79, 0x59, 0x58, 0x41, 0x53, 0x45, 0x45, 0x41, 0x41, 0x58, 0x44, 0x4D, 0x42, 0x47,
+		0x53, 0x4D, 0x53, 0x52, 0x44, 0x4D, 0x42, 0x47, 0x34, 0x45, 0x43, 0x45, 0x6A, 0x63, 0x42, 0x47,
+		0x67, 0x43, 0x41, 0x42, 0x48, 0x4E, 0x33, 0x59, 0x58, 0x41, 0x53, 0x45, 0x45, 0x41, 0x43, 0x4F,
+		0x7A, 0x4D, 0x42, 0x47, 0x79, 0x49, 0x53, 0x52, 0x44, 0x63, 0x42, 0x47, 0x67, 0x43, 0x41, 0x42,
+		0x47, 0x31, 0x70, 0x62, 0x6E, 0x51, 0x53, 0x51, 0x41, 0x45, 0x37, 0x4E, 0x77, 0x45, 0x61, 0x41,
+		0x49, 0x41, 0x45, 0x59, 0x6E, 0x56, 0x79, 0x62, 0x68, 0x4A, 0x41, 0x41, 0x5A, 0x67, 0x33, 0x41,
+		0x52, 0x6F, 0x41, 0x67, 0x41, 0x5A, 0x79, 0x5A, 0x57, 0x52, 0x6C, 0x5A, 0x57, 0x30, 0x53, 0x51,
+		0x41, 0x4A, 0x62, 0x4E, 0x77, 0x45, 0x61, 0x41, 0x49, 0x41, 0x45, 0x5A, 0x6D, 0x56, 0x6C, 0x63,
+		0x78, 0x4A, 0x41, 0x41, 0x6E, 0x6B, 0x41, 0x49, 0x51, 0x59, 0x68, 0x42, 0x53, 0x51, 0x6A, 0x45,
+		0x6B, 0x30, 0x79, 0x42, 0x42, 0x4A, 0x45, 0x4E, 0x77, 0x45, 0x61, 0x41, 0x52, 0x63, 0x6C, 0x45,
+		0x6A, 0x63, 0x42, 0x47, 0x67, 0x49, 0x58, 0x4A, 0x42, 0x49, 0x51, 0x52, 0x44, 0x4D, 0x43, 0x41,
+		0x44, 0x45, 0x41, 0x45, 0x6B, 0x51, 0x7A, 0x41, 0x68, 0x41, 0x68, 0x42, 0x42, 0x4A, 0x45, 0x4D,
+		0x77, 0x49, 0x68, 0x49, 0x78, 0x4A, 0x45, 0x4D, 0x77, 0x49, 0x69, 0x49, 0x78, 0x77, 0x53, 0x52,
+		0x44, 0x4D, 0x43, 0x49, 0x79, 0x45, 0x48, 0x45, 0x6B, 0x51, 0x7A, 0x41, 0x69, 0x51, 0x6A, 0x45,
+		0x6B, 0x51, 0x7A, 0x41, 0x69, 0x57, 0x41, 0x43, 0x46, 0x52, 0x4E, 0x55, 0x45, 0x39, 0x50, 0x54,
+		0x44, 0x45, 0x78, 0x45, 0x6B, 0x51, 0x7A, 0x41, 0x69, 0x5A, 0x52, 0x41, 0x41, 0x2B, 0x41, 0x44,
+		0x31, 0x52, 0x70, 0x62, 0x6E, 0x6C, 0x74, 0x59, 0x57, 0x35, 0x51, 0x62, 0x32, 0x39, 0x73, 0x4D,
+		0x53, 0x34, 0x78, 0x49, 0x42, 0x4A, 0x45, 0x4D, 0x77, 0x49, 0x6E, 0x67, 0x42, 0x4E, 0x6F, 0x64,
+		0x48, 0x52, 0x77, 0x63, 0x7A, 0x6F, 0x76, 0x4C, 0x33, 0x52, 0x70, 0x62, 0x6E, 0x6C, 0x74, 0x59,
+		0x57, 0x34, 0x75, 0x62, 0x33, 0x4A, 0x6E, 0x45, 0x6B, 0x51, 0x7A, 0x41, 0x69, 0x6B, 0x79, 0x41,
+		0x78, 0x4A, 0x45, 0x4D, 0x77, 0x49, 0x71, 0x4D, 0x67, 0x4D, 0x53, 0x52, 0x44, 0x4D, 0x43, 0x4B,
+		0x7A, 0x49, 0x44, 0x45, 0x6B, 0x51, 0x7A, 0x41, 0x69, 0x77, 0x79, 0x41, 0x78, 0x4A, 0x45, 0x4D,
+		0x77, 0x4D, 0x41, 0x4D, 0x51, 0x41, 0x53, 0x52, 0x44, 0x4D, 0x44, 0x45, 0x43, 0x45, 0x46, 0x45,
+		0x6B, 0x51, 0x7A, 0x41, 0x78, 0x45, 0x6C, 0x45, 0x6B, 0x51, 0x7A, 0x41, 0x78, 0x51, 0x78, 0x41,
+		0x42, 0x4A, 0x45, 0x4D, 0x77, 0x4D, 0x53, 0x49, 0x78, 0x4A, 0x45, 0x4A, 0x43, 0x4D, 0x54, 0x51,
+		0x41, 0x41, 0x51, 0x4D, 0x77, 0x45, 0x42, 0x4D, 0x77, 0x49, 0x42, 0x43, 0x44, 0x4D, 0x44, 0x41,
+		0x51, 0x67, 0x31, 0x41, 0x55, 0x49, 0x42, 0x73, 0x54, 0x4D, 0x45, 0x41, 0x44, 0x45, 0x41, 0x45,
+		0x6B, 0x51, 0x7A, 0x42, 0x42, 0x41, 0x68, 0x42, 0x52, 0x4A, 0x45, 0x4D, 0x77, 0x51, 0x52, 0x4A,
+		0x42, 0x4A, 0x45, 0x4D, 0x77, 0x51, 0x55, 0x4D, 0x51, 0x41, 0x53, 0x52, 0x44, 0x4D, 0x45, 0x45,
+		0x69, 0x4D, 0x53, 0x52, 0x44, 0x4D, 0x42, 0x41, 0x54, 0x4D, 0x43, 0x41, 0x51, 0x67, 0x7A, 0x41,
+		0x77, 0x45, 0x49, 0x4D, 0x77, 0x51, 0x42, 0x43, 0x44, 0x55, 0x42, 0x51, 0x67, 0x46, 0x38, 0x4D,
+		0x67, 0x51, 0x68, 0x42, 0x68, 0x4A, 0x45, 0x4E, 0x77, 0x45, 0x63, 0x41, 0x54, 0x45, 0x41, 0x45,
+		0x30, 0x51, 0x33, 0x41, 0x52, 0x77, 0x42, 0x4D, 0x77, 0x51, 0x55, 0x45, 0x6B, 0x51, 0x7A, 0x41,
+		0x67, 0x41, 0x78, 0x41, 0x42, 0x4E, 0x45, 0x4D, 0x77, 0x49, 0x55, 0x4D, 0x51, 0x41, 0x53, 0x52,
+		0x44, 0x4D, 0x44, 0x41, 0x44, 0x4D, 0x43, 0x41, 0x42, 0x4A, 0x45, 0x4D, 0x77, 0x49, 0x52, 0x4A,
+		0x52, 0x4A, 0x45, 0x4D, 0x77, 0x4D, 0x55, 0x4D, 0x77, 0x4D, 0x48, 0x4D, 0x77, 0x4D, 0x51, 0x49,
+		0x68, 0x4A, 0x4E, 0x4D, 0x51, 0x41, 0x53, 0x52, 0x44, 0x4D, 0x44, 0x45, 0x53, 0x4D, 0x7A, 0x41,
+		0x78, 0x41, 0x69, 0x45, 0x6B, 0x30, 0x6B, 0x45, 0x6B, 0x51, 0x7A, 0x42, 0x41, 0x41, 0x78, 0x41,
+		0x42, 0x4A, 0x45, 0x4D, 0x77, 0x51, 0x55, 0x4D, 0x77, 0x49, 0x41, 0x45, 0x6B, 0x51, 0x7A, 0x41,
+		0x51, 0x45, 0x7A, 0x42, 0x41, 0x45, 0x49, 0x4E, 0x51, 0x46, 0x43, 0x41, 0x52, 0x45, 0x79, 0x42,
+		0x43, 0x45, 0x47, 0x45, 0x6B, 0x51, 0x33, 0x41, 0x52, 0x77, 0x42, 0x4D, 0x51, 0x41, 0x54, 0x52,
+		0x44, 0x63, 0x42, 0x48, 0x41, 0x45, 0x7A, 0x41, 0x68, 0x51, 0x53, 0x52, 0x44, 0x4D, 0x44, 0x46,
+		0x44, 0x4D, 0x44, 0x42, 0x7A, 0x4D, 0x44, 0x45, 0x43, 0x49, 0x53, 0x54, 0x54, 0x63, 0x42, 0x48,
+		0x41, 0x45, 0x53, 0x52, 0x44, 0x4D, 0x43, 0x41, 0x44, 0x45, 0x41, 0x45, 0x6B, 0x51, 0x7A, 0x41,
+		0x68, 0x51, 0x7A, 0x42, 0x41, 0x41, 0x53, 0x52, 0x44, 0x4D, 0x43, 0x45, 0x53, 0x55, 0x53, 0x52,
+		0x44, 0x4D, 0x44, 0x41, 0x44, 0x45, 0x41, 0x45, 0x6B, 0x51, 0x7A, 0x41, 0x78, 0x51, 0x7A, 0x41,
+		0x77, 0x63, 0x7A, 0x41, 0x78, 0x41, 0x69, 0x45, 0x6B, 0x30, 0x7A, 0x42, 0x41, 0x41, 0x53, 0x52,
+		0x44, 0x4D, 0x44, 0x45, 0x53, 0x4D, 0x7A, 0x41, 0x78, 0x41, 0x69, 0x45, 0x6B, 0x30, 0x6B, 0x45,
+		0x6B, 0x51, 0x7A, 0x42, 0x41, 0x41, 0x78, 0x41, 0x42, 0x4E, 0x45, 0x4D, 0x77, 0x51, 0x55, 0x4D,
+		0x51, 0x41, 0x53, 0x52, 0x44, 0x4D, 0x42, 0x41, 0x54, 0x4D, 0x43, 0x41, 0x51, 0x67, 0x7A, 0x41,
+		0x77, 0x45, 0x49, 0x4E, 0x51, 0x46, 0x43, 0x41, 0x4A, 0x41, 0x79, 0x42, 0x43, 0x45, 0x46, 0x45,
+		0x6B, 0x51, 0x33, 0x41, 0x52, 0x77, 0x42, 0x4D, 0x51, 0x41, 0x54, 0x52, 0x44, 0x4D, 0x43, 0x41,
+		0x44, 0x63, 0x42, 0x48, 0x41, 0x45, 0x53, 0x52, 0x44, 0x4D, 0x43, 0x41, 0x44, 0x45, 0x41, 0x45,
+		0x30, 0x51, 0x7A, 0x41, 0x77, 0x41, 0x78, 0x41, 0x42, 0x4A, 0x45, 0x4D, 0x77, 0x49, 0x55, 0x4D,
+		0x77, 0x49, 0x48, 0x4D, 0x77, 0x49, 0x51, 0x49, 0x68, 0x4A, 0x4E, 0x4D, 0x51, 0x41, 0x53, 0x52,
+		0x44, 0x4D, 0x44, 0x46, 0x44, 0x4D, 0x44, 0x42, 0x7A, 0x4D, 0x44, 0x45, 0x43, 0x49, 0x53, 0x54,
+		0x54, 0x4D, 0x43, 0x41, 0x42, 0x4A, 0x45, 0x4D, 0x77, 0x45, 0x42, 0x4D, 0x77, 0x4D, 0x42, 0x43,
+		0x44, 0x55, 0x42, 0x51, 0x67, 0x41, 0x2B, 0x4D, 0x67, 0x51, 0x68, 0x42, 0x42, 0x4A, 0x45, 0x4E,
+		0x77, 0x45, 0x63, 0x41, 0x54, 0x45, 0x41, 0x45, 0x30, 0x51, 0x7A, 0x41, 0x68, 0x51, 0x7A, 0x41,
+		0x67, 0x63, 0x7A, 0x41, 0x68, 0x41, 0x69, 0x45, 0x6B, 0x30, 0x33, 0x41, 0x52, 0x77, 0x42, 0x45,
+		0x6B, 0x51, 0x7A, 0x41, 0x51, 0x45, 0x7A, 0x41, 0x67, 0x45, 0x49, 0x4E, 0x51, 0x46, 0x43, 0x41,
+		0x42, 0x49, 0x79, 0x42, 0x43, 0x45, 0x45, 0x45, 0x6B, 0x51, 0x7A, 0x41, 0x51, 0x45, 0x7A, 0x41,
+		0x67, 0x45, 0x49, 0x4E, 0x51, 0x46, 0x43, 0x41, 0x41, 0x41, 0x7A, 0x41, 0x41, 0x41, 0x78, 0x41,
+		0x42, 0x4E, 0x45, 0x4D, 0x77, 0x41, 0x48, 0x4D, 0x51, 0x41, 0x53, 0x52, 0x44, 0x4D, 0x41, 0x43,
+		0x44, 0x51, 0x42, 0x44, 0x30, 0x4D, 0x3D, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+		0x20, 0x20, 0x22, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3A, 0x20, 0x22, 0x41, 0x42,
+		0x55, 0x4B, 0x41, 0x58, 0x54, 0x41, 0x4E, 0x57, 0x52, 0x36, 0x4B, 0x36, 0x5A, 0x59, 0x56, 0x37,
+		0x35, 0x44, 0x57, 0x4A, 0x45, 0x50, 0x56, 0x57, 0x57, 0x4F, 0x55, 0x36, 0x53, 0x46, 0x55, 0x56,
+		0x52, 0x49, 0x36, 0x51, 0x48, 0x4F, 0x34, 0x34, 0x45, 0x34, 0x53, 0x49, 0x44, 0x4C, 0x48, 0x42,
+		0x54, 0x44, 0x32, 0x43, 0x5A, 0x36, 0x34, 0x41, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20,
+		0x20, 0x20, 0x20, 0x22, 0x73, 0x69, 0x7A, 0x65, 0x22, 0x3A, 0x20, 0x38, 0x38, 0x31, 0x2C, 0x0A,
+		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6C,
+		0x65, 0x73, 0x22, 0x3A, 0x20, 0x5B, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+		0x20, 0x7B, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+		0x6E, 0x61, 0x6D, 0x65, 0x22, 0x3A, 0x20, 0x22, 0x54, 0x4D, 0x50, 0x4C, 0x5F, 0x41, 0x53, 0x53,
+		0x45, 0x54, 0x5F, 0x49, 0x44, 0x5F, 0x31, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3A, 0x20, 0x22, 0x69,
+		0x6E, 0x74, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+		0x20, 0x22, 0x69, 0x6E, 0x64, 0x65, 0x78, 0x22, 0x3A, 0x20, 0x31, 0x35, 0x2C, 0x0A, 0x20, 0x20,
+		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6C, 0x65, 0x6E, 0x67, 0x74,
+		0x68, 0x22, 0x3A, 0x20, 0x31, 0x30, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+		0x20, 0x7D, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7B, 0x0A,
+		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6E, 0x61, 0x6D,
+		0x65, 0x22, 0x3A, 0x20, 0x22, 0x54, 0x4D, 0x50, 0x4C, 0x5F, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5F,
+		0x49, 0x44, 0x5F, 0x32, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+		0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3A, 0x20, 0x22, 0x69, 0x6E, 0x74, 0x22,
+		0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x69,
+		0x6E, 0x64, 0x65, 0x78, 0x22, 0x3A, 0x20, 0x35, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6C, 0x65, 0x6E, 0x67, 0x74, 0x68, 0x22, 0x3A, 0x20,
+		0x31, 0x30, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7D, 0x2C, 0x0A,
+		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7B, 0x0A, 0x20, 0x20, 0x20, 0x20,
+		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6E, 0x61, 0x6D, 0x65, 0x22, 0x3A, 0x20,
+		0x22, 0x54, 0x4D, 0x50, 0x4C, 0x5F, 0x56, 0x41, 0x4C, 0x49, 0x44, 0x41, 0x54, 0x4F, 0x52, 0x5F,
+		0x41, 0x50, 0x50, 0x5F, 0x49, 0x44, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+		0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x74, 0x79, 0x70, 0x65, 0x22, 0x3A, 0x20, 0x22, 0x69, 0x6E,
+		0x74, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+		0x22, 0x69, 0x6E, 0x64, 0x65, 0x78, 0x22, 0x3A, 0x20, 0x37, 0x34, 0x2C, 0x0A, 0x20, 0x20, 0x20,
+		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6C, 0x65, 0x6E, 0x67, 0x74, 0x68,
+		0x22, 0x3A, 0x20, 0x31, 0x30, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+		0x7D, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x5D, 0x2C, 0x0A, 0x20, 0x20, 0x20,
+		0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x6F, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3A, 0x20, 0x22,
+		0x68, 0x74, 0x74, 0x70, 0x73, 0x3A, 0x2F, 0x2F, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2E, 0x63,
+		0x6F, 0x6D, 0x2F, 0x74, 0x69, 0x6E, 0x79, 0x6D, 0x61, 0x6E, 0x6F, 0x72, 0x67, 0x2F, 0x74, 0x69,
+		0x6E, 0x79, 0x6D, 0x61, 0x6E, 0x2D, 0x63, 0x6F, 0x6E, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x2D,
+		0x76, 0x31, 0x2F, 0x74, 0x72, 0x65, 0x65, 0x2F, 0x64, 0x63, 0x39, 0x61, 0x62, 0x34, 0x30, 0x63,
+		0x35, 0x38, 0x62, 0x38, 0x35, 0x63, 0x31, 0x35, 0x64, 0x35, 0x38, 0x66, 0x36, 0x33, 0x61, 0x31,
+		0x35, 0x30, 0x37, 0x65, 0x31, 0x38, 0x62, 0x65, 0x37, 0x36, 0x37, 0x32, 0x30, 0x64, 0x62, 0x62,
+		0x2F, 0x63, 0x6F, 0x6E, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x2F, 0x70, 0x6F, 0x6F, 0x6C, 0x5F,
+		0x6C, 0x6F, 0x67, 0x69, 0x63, 0x73, 0x69, 0x67, 0x2E, 0x74, 0x65, 0x61, 0x6C, 0x2E, 0x74, 0x6D,
+		0x70, 0x6C, 0x22, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7D, 0x2C, 0x0A, 0x20, 0x20, 0x20,
+		0x20, 0x20, 0x20, 0x22, 0x6E, 0x61, 0x6D, 0x65, 0x22, 0x3A, 0x20, 0x22, 0x70, 0x6F, 0x6F, 0x6C,
+		0x5F, 0x6C, 0x6F, 0x67, 0x69, 0x63, 0x73, 0x69, 0x67, 0x22, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x7D,
+		0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61, 0x6C, 0x69, 0x64, 0x61, 0x74, 0x6F, 0x72,
+		0x5F, 0x61, 0x70, 0x70, 0x22, 0x3A, 0x20, 0x7B, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+		0x74, 0x79, 0x70, 0x65, 0x22, 0x3A, 0x20, 0x22, 0x61, 0x70, 0x70, 0x22, 0x2C, 0x0A, 0x20, 0x20,
+		0x20, 0x20, 0x20, 0x20, 0x22, 0x61, 0x70, 0x70, 0x72, 0x6F, 0x76, 0x61, 0x6C, 0x5F, 0x70, 0x72,
+		0x6F, 0x67, 0x72, 0x61, 0x6D, 0x22, 0x3A, 0x20, 0x7B, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+		0x20, 0x20, 0x22, 0x62, 0x79, 0x74, 0x65, 0x63, 0x6F, 0x64, 0x65, 0x22, 0x3A, 0x20, 0x22, 0x42,
+		0x43, 0x41, 0x48, 0x41, 0x41, 0x48, 0x6F, 0x42, 0x2B, 0x55, 0x48, 0x42, 0x66, 0x2F, 0x2F, 0x2F,
+		0x2F, 0x2F, 0x2F, 0x2F, 0x2F, 0x2F, 0x2F, 0x2F, 0x77, 0x48, 0x41, 0x68, 0x44, 0x30, 0x6D, 0x44,
+		0x51, 0x46, 0x76, 0x41, 0x57, 0x55, 0x42, 0x63, 0x41, 0x4A, 0x68, 0x4D, 0x51, 0x4A, 0x68, 0x4D,
+		0x67, 0x4A, 0x73, 0x64, 0x41, 0x52, 0x7A, 0x64, 0x32, 0x46, 0x77, 0x42, 0x47, 0x31, 0x70, 0x62,
+		0x6E, 0x51, 0x42, 0x64, 0x41, 0x4A, 0x6A, 0x4D, 0x51, 0x4A, 0x77, 0x4D, 0x51, 0x4A, 0x6A, 0x4D,
+		0x67, 0x4A, 0x77, 0x4D, 0x6A, 0x45, 0x5A, 0x67, 0x51, 0x51, 0x53, 0x4D, 0x52, 0x6B, 0x68, 0x42,
+		0x42, 0x49, 0x52, 0x4D, 0x52, 0x6D, 0x42, 0x41, 0x68, 0x49, 0x52, 0x51, 0x41, 0x54, 0x78, 0x4D,
+		0x52, 0x6B, 0x6A, 0x45, 0x6A, 0x45, 0x62, 0x49, 0x68, 0x49, 0x51, 0x51, 0x41, 0x54, 0x6A, 0x4E,
+		0x68, 0x6F, 0x41, 0x67, 0x41, 0x5A, 0x6A, 0x63, 0x6D, 0x56, 0x68, 0x64, 0x47, 0x55, 0x53, 0x51,
+		0x41, 0x54, 0x55, 0x4D, 0x52, 0x6B, 0x6A, 0x45, 0x6A, 0x59, 0x61, 0x41, 0x49, 0x41, 0x4A, 0x59,
+		0x6D, 0x39, 0x76, 0x64, 0x48, 0x4E, 0x30, 0x63, 0x6D, 0x46, 0x77, 0x45, 0x68, 0x42, 0x41, 0x41,
+		0x2F, 0x4D, 0x7A, 0x41, 0x68, 0x49, 0x7A, 0x41, 0x67, 0x67, 0x49, 0x4E, 0x54, 0x51, 0x69, 0x4B,
+		0x32, 0x49, 0x31, 0x5A, 0x53, 0x49, 0x30, 0x5A, 0x58, 0x41, 0x41, 0x52, 0x44, 0x55, 0x42, 0x49,
+		0x69, 0x63, 0x45, 0x59, 0x6A, 0x56, 0x6D, 0x4E, 0x47, 0x5A, 0x41, 0x41, 0x42, 0x45, 0x69, 0x59,
+		0x43, 0x4A, 0x34, 0x43, 0x54, 0x45, 0x42, 0x43, 0x44, 0x4D, 0x41, 0x43, 0x41, 0x6B, 0x31, 0x41,
+		0x6B, 0x49, 0x41, 0x43, 0x43, 0x49, 0x30, 0x5A, 0x6E, 0x41, 0x41, 0x52, 0x44, 0x55, 0x43, 0x49,
+		0x69, 0x63, 0x46, 0x59, 0x6A, 0x56, 0x6E, 0x4B, 0x44, 0x52, 0x6C, 0x46, 0x6C, 0x41, 0x31, 0x62,
+		0x79, 0x49, 0x30, 0x62, 0x32, 0x49, 0x31, 0x50, 0x53, 0x67, 0x30, 0x5A, 0x68, 0x5A, 0x51, 0x4E,
+		0x58, 0x41, 0x69, 0x4E, 0x48, 0x42, 0x69, 0x4E, 0x54, 0x34, 0x6F, 0x4E, 0x47, 0x63, 0x57, 0x55,
+		0x44, 0x56, 0x78, 0x49, 0x6A, 0x52, 0x78, 0x59, 0x6A, 0x55, 0x2F, 0x49, 0x69, 0x70, 0x69, 0x4E,
+		0x55, 0x41, 0x30, 0x41, 0x54, 0x51, 0x39, 0x43, 0x54, 0x56, 0x48, 0x4E, 0x41, 0x49, 0x30, 0x50,
+		0x67, 0x6B, 0x31, 0x53, 0x44, 0x45, 0x41, 0x4B, 0x56, 0x41, 0x30, 0x5A, 0x52, 0x5A, 0x51, 0x4E,
+		0x58, 0x6B, 0x78, 0x41, 0x43, 0x6C, 0x51, 0x4E, 0x47, 0x59, 0x57, 0x55, 0x44, 0x56, 0x36, 0x4D,
+		0x51, 0x41, 0x70, 0x55, 0x44, 0x52, 0x6E, 0x46, 0x6C, 0x41, 0x31, 0x65, 0x7A, 0x59, 0x61, 0x41,
+		0x49, 0x41, 0x47, 0x63, 0x6D, 0x56, 0x6B, 0x5A, 0x57, 0x56, 0x74, 0x45, 0x6B, 0x41, 0x41, 0x57,
+		0x6A, 0x59, 0x61, 0x41, 0x49, 0x41, 0x45, 0x5A, 0x6D, 0x56, 0x6C, 0x63, 0x78, 0x4A, 0x41, 0x41,
+		0x42, 0x77, 0x32, 0x47, 0x67, 0x41, 0x6E, 0x42, 0x68, 0x49, 0x32, 0x47, 0x67, 0x41, 0x6E, 0x42,
+		0x78, 0x49, 0x52, 0x4E, 0x68, 0x6F, 0x41, 0x67, 0x41, 0x52, 0x69, 0x64, 0x58, 0x4A, 0x75, 0x45,
+		0x68, 0x46, 0x41, 0x41, 0x47, 0x30, 0x41, 0x4E, 0x47, 0x64, 0x4A, 0x52, 0x44, 0x4D, 0x43, 0x45,
+		0x52, 0x4A, 0x45, 0x4D, 0x77, 0x49, 0x53, 0x52, 0x44, 0x4D, 0x43, 0x46, 0x44, 0x49, 0x4A, 0x45,
+		0x6B, 0x51, 0x30, 0x50, 0x7A, 0x4D, 0x43, 0x45, 0x67, 0x6B, 0x31, 0x50, 0x7A, 0x52, 0x41, 0x4D,
+		0x77, 0x49, 0x53, 0x43, 0x54, 0x56, 0x41, 0x49, 0x69, 0x6F, 0x30, 0x51, 0x47, 0x59, 0x69, 0x4E,
+		0x48, 0x45, 0x30, 0x50, 0x32, 0x59, 0x6A, 0x51, 0x7A, 0x4D, 0x43, 0x46, 0x44, 0x4D, 0x43, 0x42,
+		0x7A, 0x4D, 0x43, 0x45, 0x43, 0x4D, 0x53, 0x54, 0x54, 0x59, 0x63, 0x41, 0x52, 0x4A, 0x45, 0x4E,
+		0x44, 0x52, 0x45, 0x49, 0x69, 0x67, 0x7A, 0x41, 0x68, 0x45, 0x57, 0x55, 0x45, 0x70, 0x69, 0x4E,
+		0x44, 0x51, 0x4A, 0x5A, 0x69, 0x4D, 0x78, 0x41, 0x43, 0x6C, 0x51, 0x4D, 0x77, 0x49, 0x52, 0x46,
+		0x6C, 0x42, 0x4B, 0x59, 0x6A, 0x51, 0x30, 0x43, 0x55, 0x6C, 0x42, 0x41, 0x41, 0x4E, 0x6D, 0x49,
+		0x30, 0x4E, 0x49, 0x61, 0x43, 0x4E, 0x44, 0x4D, 0x67, 0x63, 0x69, 0x4A, 0x77, 0x68, 0x69, 0x43,
+		0x55, 0x6B, 0x31, 0x2B, 0x6B, 0x45, 0x41, 0x52, 0x69, 0x49, 0x6E, 0x43, 0x57, 0x49, 0x69, 0x4A,
+		0x77, 0x70, 0x69, 0x4E, 0x50, 0x6F, 0x64, 0x54, 0x45, 0x41, 0x41, 0x4E, 0x78, 0x34, 0x68, 0x42,
+		0x53, 0x4D, 0x65, 0x48, 0x7A, 0x58, 0x37, 0x53, 0x45, 0x68, 0x49, 0x49, 0x69, 0x63, 0x4C, 0x59,
+		0x69, 0x49, 0x6E, 0x44, 0x47, 0x49, 0x30, 0x2B, 0x68, 0x31, 0x4D, 0x51, 0x41, 0x41, 0x64, 0x48,
+		0x69, 0x45, 0x46, 0x49, 0x78, 0x34, 0x66, 0x4E, 0x66, 0x78, 0x49, 0x53, 0x45, 0x67, 0x69, 0x4A,
+		0x77, 0x6B, 0x30, 0x2B, 0x32, 0x59, 0x69, 0x4A, 0x77, 0x73, 0x30, 0x2F, 0x47, 0x59, 0x69, 0x4A,
+		0x77, 0x67, 0x79, 0x42, 0x32, 0x59, 0x7A, 0x41, 0x78, 0x49, 0x7A, 0x41, 0x77, 0x67, 0x49, 0x4E,
+		0x54, 0x55, 0x32, 0x48, 0x41, 0x45, 0x78, 0x41, 0x42, 0x4E, 0x45, 0x4E, 0x47, 0x64, 0x42, 0x41,
+		0x43, 0x49, 0x69, 0x4E, 0x47, 0x64, 0x77, 0x41, 0x45, 0x51, 0x31, 0x42, 0x69, 0x49, 0x63, 0x4E,
+		0x41, 0x59, 0x4A, 0x4E, 0x44, 0x38, 0x49, 0x4E, 0x51, 0x51, 0x32, 0x47, 0x67, 0x41, 0x6E, 0x42,
+		0x68, 0x4A, 0x41, 0x41, 0x53, 0x41, 0x30, 0x5A, 0x7A, 0x4D, 0x45, 0x45, 0x52, 0x4A, 0x45, 0x4E,
+		0x68, 0x6F, 0x41, 0x4A, 0x77, 0x63, 0x53, 0x51, 0x41, 0x42, 0x56, 0x4E, 0x68, 0x77, 0x42, 0x4D,
+		0x77, 0x51, 0x41, 0x45, 0x6B, 0x51, 0x7A, 0x42, 0x42, 0x49, 0x30, 0x52, 0x78, 0x30, 0x30, 0x42,
+		0x43, 0x4D, 0x64, 0x48, 0x30, 0x68, 0x49, 0x54, 0x45, 0x68, 0x4A, 0x4E, 0x52, 0x41, 0x30, 0x4E,
+		0x41, 0x6B, 0x31, 0x79, 0x54, 0x4D, 0x45, 0x45, 0x6A, 0x52, 0x49, 0x48, 0x54, 0x51, 0x45, 0x49,
+		0x78, 0x30, 0x66, 0x53, 0x45, 0x68, 0x4D, 0x53, 0x45, 0x6B, 0x31, 0x45, 0x54, 0x51, 0x31, 0x43,
+		0x54, 0x58, 0x4B, 0x4E, 0x42, 0x41, 0x30, 0x45, 0x52, 0x42, 0x45, 0x4E, 0x45, 0x63, 0x30, 0x45,
+		0x41, 0x6B, 0x31, 0x55, 0x54, 0x52, 0x49, 0x4E, 0x42, 0x45, 0x4A, 0x4E, 0x56, 0x49, 0x30, 0x42,
+		0x44, 0x4D, 0x45, 0x45, 0x67, 0x6B, 0x31, 0x55, 0x30, 0x49, 0x43, 0x43, 0x6A, 0x59, 0x63, 0x41,
+		0x54, 0x4D, 0x43, 0x41, 0x42, 0x4A, 0x45, 0x4E, 0x45, 0x63, 0x30, 0x4E, 0x41, 0x67, 0x31, 0x55,
+		0x54, 0x52, 0x49, 0x4E, 0x44, 0x55, 0x49, 0x4E, 0x56, 0x49, 0x30, 0x42, 0x43, 0x49, 0x53, 0x51,
+		0x41, 0x41, 0x75, 0x4E, 0x44, 0x51, 0x30, 0x42, 0x42, 0x30, 0x30, 0x52, 0x79, 0x4D, 0x64, 0x48,
+		0x30, 0x68, 0x49, 0x54, 0x45, 0x67, 0x30, 0x4E, 0x54, 0x51, 0x45, 0x48, 0x54, 0x52, 0x49, 0x49,
+		0x78, 0x30, 0x66, 0x53, 0x45, 0x68, 0x4D, 0x53, 0x45, 0x6F, 0x4E, 0x54, 0x55, 0x6B, 0x30, 0x42,
+		0x41, 0x67, 0x31, 0x55, 0x7A, 0x4D, 0x45, 0x45, 0x67, 0x6B, 0x31, 0x79, 0x30, 0x49, 0x42, 0x76,
+		0x79, 0x49, 0x6E, 0x42, 0x54, 0x4D, 0x45, 0x45, 0x55, 0x6B, 0x31, 0x5A, 0x32, 0x59, 0x6F, 0x4E,
+		0x47, 0x63, 0x57, 0x55, 0x44, 0x56, 0x78, 0x49, 0x6A, 0x52, 0x6E, 0x63, 0x41, 0x42, 0x45, 0x52,
+		0x44, 0x52, 0x6E, 0x4E, 0x47, 0x55, 0x54, 0x52, 0x44, 0x52, 0x6E, 0x4E, 0x47, 0x59, 0x54, 0x52,
+		0x44, 0x4D, 0x45, 0x45, 0x69, 0x51, 0x49, 0x53, 0x52, 0x30, 0x31, 0x38, 0x44, 0x51, 0x30, 0x4E,
+		0x44, 0x55, 0x64, 0x4E, 0x66, 0x46, 0x4B, 0x44, 0x45, 0x41, 0x41, 0x43, 0x42, 0x4A, 0x45, 0x4E,
+		0x50, 0x41, 0x30, 0x38, 0x51, 0x35, 0x45, 0x4D, 0x77, 0x51, 0x53, 0x4A, 0x41, 0x67, 0x6A, 0x43,
+		0x45, 0x6B, 0x64, 0x4E, 0x66, 0x41, 0x30, 0x4E, 0x44, 0x51, 0x31, 0x48, 0x54, 0x58, 0x78, 0x53,
+		0x67, 0x31, 0x41, 0x41, 0x41, 0x67, 0x53, 0x52, 0x44, 0x54, 0x77, 0x4E, 0x50, 0x45, 0x4E, 0x52,
+		0x43, 0x51, 0x31, 0x50, 0x7A, 0x51, 0x45, 0x4D, 0x77, 0x51, 0x53, 0x4A, 0x41, 0x67, 0x49, 0x4E,
+		0x56, 0x4E, 0x43, 0x41, 0x55, 0x38, 0x32, 0x48, 0x41, 0x45, 0x7A, 0x41, 0x67, 0x41, 0x53, 0x52,
+		0x44, 0x4D, 0x43, 0x45, 0x54, 0x52, 0x6C, 0x45, 0x6A, 0x4D, 0x44, 0x45, 0x54, 0x52, 0x6D, 0x45,
+		0x68, 0x42, 0x4A, 0x4E, 0x57, 0x52, 0x41, 0x41, 0x42, 0x6B, 0x7A, 0x41, 0x68, 0x45, 0x30, 0x5A,
+		0x68, 0x49, 0x7A, 0x41, 0x78, 0x45, 0x30, 0x5A, 0x52, 0x49, 0x51, 0x52, 0x44, 0x52, 0x49, 0x4E,
+		0x52, 0x49, 0x30, 0x52, 0x7A, 0x55, 0x54, 0x51, 0x67, 0x41, 0x49, 0x4E, 0x45, 0x63, 0x31, 0x45,
+		0x6A, 0x52, 0x49, 0x4E, 0x52, 0x4D, 0x32, 0x47, 0x67, 0x47, 0x41, 0x41, 0x6D, 0x5A, 0x70, 0x45,
+		0x6B, 0x41, 0x41, 0x57, 0x6A, 0x59, 0x61, 0x41, 0x59, 0x41, 0x43, 0x5A, 0x6D, 0x38, 0x53, 0x52,
+		0x44, 0x51, 0x31, 0x4A, 0x41, 0x73, 0x30, 0x45, 0x68, 0x30, 0x30, 0x45, 0x7A, 0x51, 0x31, 0x43,
+		0x53, 0x55, 0x64, 0x48, 0x30, 0x68, 0x49, 0x54, 0x45, 0x67, 0x6A, 0x43, 0x45, 0x6B, 0x31, 0x46,
+		0x53, 0x49, 0x4E, 0x4E, 0x44, 0x55, 0x30, 0x45, 0x77, 0x77, 0x51, 0x52, 0x44, 0x51, 0x30, 0x4E,
+		0x42, 0x55, 0x4A, 0x4E, 0x47, 0x52, 0x42, 0x41, 0x42, 0x4D, 0x31, 0x79, 0x54, 0x52, 0x48, 0x4E,
+		0x42, 0x55, 0x49, 0x4E, 0x56, 0x45, 0x30, 0x53, 0x44, 0x51, 0x31, 0x43, 0x54, 0x56, 0x53, 0x51,
+		0x67, 0x42, 0x6E, 0x4E, 0x63, 0x6F, 0x30, 0x53, 0x44, 0x51, 0x56, 0x43, 0x44, 0x56, 0x53, 0x4E,
+		0x45, 0x63, 0x30, 0x4E, 0x51, 0x6B, 0x31, 0x55, 0x55, 0x49, 0x41, 0x56, 0x44, 0x51, 0x30, 0x53,
+		0x54, 0x55, 0x56, 0x4A, 0x51, 0x73, 0x30, 0x45, 0x78, 0x30, 0x30, 0x45, 0x69, 0x51, 0x4C, 0x4E,
+		0x44, 0x51, 0x6C, 0x43, 0x78, 0x34, 0x66, 0x53, 0x45, 0x68, 0x4D, 0x53, 0x45, 0x6B, 0x31, 0x46,
+		0x43, 0x49, 0x4E, 0x4E, 0x42, 0x51, 0x30, 0x45, 0x77, 0x77, 0x51, 0x52, 0x44, 0x51, 0x55, 0x4E,
+		0x44, 0x55, 0x4A, 0x4E, 0x47, 0x52, 0x42, 0x41, 0x42, 0x4D, 0x31, 0x79, 0x6A, 0x52, 0x48, 0x4E,
+		0x44, 0x51, 0x49, 0x4E, 0x56, 0x45, 0x30, 0x53, 0x44, 0x51, 0x55, 0x43, 0x54, 0x56, 0x53, 0x51,
+		0x67, 0x41, 0x54, 0x4E, 0x63, 0x6B, 0x30, 0x52, 0x7A, 0x51, 0x55, 0x43, 0x54, 0x56, 0x52, 0x4E,
+		0x45, 0x67, 0x30, 0x4E, 0x41, 0x67, 0x31, 0x55, 0x6B, 0x49, 0x41, 0x41, 0x44, 0x51, 0x56, 0x49,
+		0x51, 0x51, 0x4C, 0x4E, 0x41, 0x51, 0x64, 0x67, 0x61, 0x43, 0x63, 0x41, 0x54, 0x51, 0x53, 0x48,
+		0x52, 0x39, 0x49, 0x53, 0x45, 0x78, 0x49, 0x53, 0x54, 0x55, 0x71, 0x4E, 0x41, 0x51, 0x49, 0x4E,
+		0x56, 0x4E, 0x43, 0x41, 0x44, 0x73, 0x69, 0x4B, 0x7A, 0x59, 0x61, 0x41, 0x52, 0x64, 0x4A, 0x4E,
+		0x57, 0x56, 0x6D, 0x49, 0x69, 0x63, 0x45, 0x4E, 0x68, 0x6F, 0x43, 0x46, 0x30, 0x6B, 0x31, 0x5A,
+		0x6D, 0x59, 0x30, 0x5A, 0x58, 0x45, 0x44, 0x52, 0x49, 0x41, 0x42, 0x4C, 0x56, 0x43, 0x41, 0x42,
+		0x45, 0x46, 0x4D, 0x52, 0x30, 0x38, 0x30, 0x5A, 0x6B, 0x45, 0x41, 0x42, 0x6B, 0x67, 0x30, 0x5A,
+		0x6E, 0x45, 0x44, 0x52, 0x46, 0x41, 0x7A, 0x41, 0x69, 0x5A, 0x4A, 0x46, 0x59, 0x45, 0x50, 0x54,
+		0x46, 0x49, 0x53, 0x51, 0x79, 0x49, 0x71, 0x4E, 0x45, 0x41, 0x30, 0x4B, 0x67, 0x68, 0x6D, 0x49,
+		0x6A, 0x52, 0x78, 0x4E, 0x44, 0x38, 0x30, 0x4B, 0x67, 0x67, 0x30, 0x79, 0x77, 0x68, 0x6D, 0x49,
+		0x6A, 0x52, 0x76, 0x4E, 0x44, 0x30, 0x30, 0x79, 0x51, 0x68, 0x6D, 0x49, 0x6A, 0x52, 0x77, 0x4E,
+		0x44, 0x34, 0x30, 0x79, 0x67, 0x68, 0x6D, 0x49, 0x6F, 0x41, 0x43, 0x63, 0x7A, 0x45, 0x30, 0x55,
+		0x57, 0x59, 0x69, 0x67, 0x41, 0x4A, 0x7A, 0x4D, 0x6A, 0x52, 0x53, 0x5A, 0x69, 0x49, 0x6E, 0x43,
+		0x6A, 0x52, 0x53, 0x49, 0x51, 0x59, 0x64, 0x4E, 0x46, 0x45, 0x6A, 0x48, 0x52, 0x39, 0x49, 0x53,
+		0x45, 0x78, 0x49, 0x5A, 0x69, 0x49, 0x6E, 0x44, 0x44, 0x52, 0x52, 0x49, 0x51, 0x59, 0x64, 0x4E,
+		0x46, 0x49, 0x6A, 0x48, 0x52, 0x39, 0x49, 0x53, 0x45, 0x78, 0x49, 0x5A, 0x69, 0x4B, 0x41, 0x41,
+		0x32, 0x6C, 0x73, 0x64, 0x44, 0x52, 0x54, 0x5A, 0x6A, 0x54, 0x4C, 0x51, 0x51, 0x41, 0x4A, 0x49,
+		0x7A, 0x52, 0x37, 0x53, 0x6D, 0x49, 0x30, 0x79, 0x77, 0x68, 0x6D, 0x4E, 0x4D, 0x6C, 0x42, 0x41,
+		0x41, 0x6B, 0x6A, 0x4E, 0x48, 0x6C, 0x4B, 0x59, 0x6A, 0x54, 0x4A, 0x43, 0x47, 0x59, 0x30, 0x79,
+		0x6B, 0x45, 0x41, 0x43, 0x53, 0x4D, 0x30, 0x65, 0x6B, 0x70, 0x69, 0x4E, 0x4D, 0x6F, 0x49, 0x5A,
+		0x69, 0x4E, 0x44, 0x49, 0x30, 0x4D, 0x69, 0x51, 0x77, 0x3D, 0x3D, 0x22, 0x2C, 0x0A, 0x20, 0x20,
+		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3A,
+		0x20, 0x22, 0x42, 0x55, 0x51, 0x48, 0x58, 0x48, 0x50, 0x4C, 0x4D, 0x59, 0x55, 0x56, 0x53, 0x33,
+		0x50, 0x32, 0x49, 0x4E, 0x4A, 0x32, 0x45, 0x55, 0x4A, 0x46, 0x43, 0x53, 0x4E, 0x54, 0x36, 0x4C,
+		0x4E, 0x55, 0x47, 0x58, 0x56, 0x4D, 0x36, 0x54, 0x32, 0x53, 0x5A, 0x32, 0x37, 0x54, 0x44, 0x52,
+		0x44, 0x59, 0x4C, 0x55, 0x4D, 0x57, 0x43, 0x46, 0x59, 0x57, 0x33, 0x45, 0x22, 0x2C, 0x0A, 0x20,
+		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x69, 0x7A, 0x65, 0x22, 0x3A, 0x20, 0x31,
+		0x33, 0x35, 0x31, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x76, 0x61,
+		0x72, 0x69, 0x61, 0x62, 0x6C, 0x65, 0x73, 0x22, 0x3A, 0x20, 0x5B, 0x5D, 0x2C, 0x0A, 0x20, 0x20,
+		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x6F, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3A, 0x20,
+		0x22, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3A, 0x2F, 0x2F, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2E,
+		0x63, 0x6F, 0x6D, 0x2F, 0x74, 0x69, 0x6E, 0x79, 0x6D, 0x61, 0x6E, 0x6F, 0x72, 0x67, 0x2F, 0x74,
+		0x69, 0x6E, 0x79, 0x6D, 0x61, 0x6E, 0x2D, 0x63, 0x6F, 0x6E, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73,
+		0x2D, 0x76, 0x31, 0x2F, 0x74, 0x72, 0x65, 0x65, 0x2F, 0x64, 0x63, 0x39, 0x61, 0x62, 0x34, 0x30,
+		0x63, 0x35, 0x38, 0x62, 0x38, 0x35, 0x63, 0x31, 0x35, 0x64, 0x35, 0x38, 0x66, 0x36, 0x33, 0x61,
+		0x31, 0x35, 0x30, 0x37, 0x65, 0x31, 0x38, 0x62, 0x65, 0x37, 0x36, 0x37, 0x32, 0x30, 0x64, 0x62,
+		0x62, 0x2F, 0x63, 0x6F, 0x6E, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x2F, 0x76, 0x61, 0x6C, 0x69,
+		0x64, 0x61, 0x74, 0x6F, 0x72, 0x5F, 0x61, 0x70, 0x70, 0x72, 0x6F, 0x76, 0x61, 0x6C, 0x2E, 0x74,
+		0x65, 0x61, 0x6C, 0x22, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7D, 0x2C, 0x0A, 0x20, 0x20,
+		0x20, 0x20, 0x20, 0x20, 0x22, 0x63, 0x6C, 0x65, 0x61, 0x72, 0x5F, 0x70, 0x72, 0x6F, 0x67, 0x72,
+		0x61, 0x6D, 0x22, 0x3A, 0x20, 0x7B, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+		0x62, 0x79, 0x74, 0x65, 0x63, 0x6F, 0x64, 0x65, 0x22, 0x3A, 0x20, 0x22, 0x42, 0x49, 0x45, 0x42,
+		0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x61, 0x64, 0x64, 0x72,
+		0x65, 0x73, 0x73, 0x22, 0x3A, 0x20, 0x22, 0x50, 0x37, 0x47, 0x45, 0x57, 0x44, 0x58, 0x58, 0x57,
+		0x35, 0x49, 0x4F, 0x4E, 0x52, 0x57, 0x36, 0x58, 0x52, 0x49, 0x52, 0x56, 0x50, 0x4A, 0x43, 0x54,
+		0x32, 0x58, 0x58, 0x45, 0x51, 0x47, 0x4F, 0x42, 0x47, 0x47, 0x36, 0x35, 0x56, 0x4A, 0x50, 0x42,
+		0x55, 0x4F, 0x59, 0x5A, 0x45, 0x4A, 0x43, 0x42, 0x5A, 0x57, 0x54, 0x50, 0x48, 0x53, 0x33, 0x56,
+		0x51, 0x22, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x69, 0x7A,
+		0x65, 0x22, 0x3A, 0x20, 0x33, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+		0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6C, 0x65, 0x73, 0x22, 0x3A, 0x20, 0x5B, 0x5D, 0x2C, 0x0A,
+		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x6F, 0x75, 0x72, 0x63, 0x65, 0x22,
+		0x3A, 0x20, 0x22, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3A, 0x2F, 0x2F, 0x67, 0x69, 0x74, 0x68, 0x75,
+		0x62, 0x2E, 0x63, 0x6F, 0x6D, 0x2F, 0x74, 0x69, 0x6E, 0x79, 0x6D, 0x61, 0x6E, 0x6F, 0x72, 0x67,
+		0x2F, 0x74, 0x69, 0x6E, 0x79, 0x6D, 0x61, 0x6E, 0x2D, 0x63, 0x6F, 0x6E, 0x74, 0x72, 0x61, 0x63,
+		0x74, 0x73, 0x2D, 0x76, 0x31, 0x2F, 0x74, 0x72, 0x65, 0x65, 0x2F, 0x64, 0x63, 0x39, 0x61, 0x62,
+		0x34, 0x30, 0x63, 0x35, 0x38, 0x62, 0x38, 0x35, 0x63, 0x31, 0x35, 0x64, 0x35, 0x38, 0x66, 0x36,
+		0x33, 0x61, 0x31, 0x35, 0x30, 0x37, 0x65, 0x31, 0x38, 0x62, 0x65, 0x37, 0x36, 0x37, 0x32, 0x30,
+		0x64, 0x62, 0x62, 0x2F, 0x63, 0x6F, 0x6E, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x2F, 0x76, 0x61,
+		0x6C, 0x69, 0x64, 0x61, 0x74, 0x6F, 0x72, 0x5F, 0x63, 0x6C, 0x65, 0x61, 0x72, 0x5F, 0x73, 0x74,
+		0x61, 0x74, 0x65, 0x2E, 0x74, 0x65, 0x61, 0x6C, 0x22, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+		0x7D, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x67, 0x6C, 0x6F, 0x62, 0x61, 0x6C,
+		0x5F, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5F, 0x73, 0x63, 0x68, 0x65, 0x6D, 0x61, 0x22, 0x3A, 0x20,
+		0x7B, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6E, 0x75, 0x6D, 0x5F, 0x75,
+		0x69, 0x6E, 0x74, 0x73, 0x22, 0x3A, 0x20, 0x30, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+		0x20, 0x20, 0x22, 0x6E, 0x75, 0x6D, 0x5F, 0x62, 0x79, 0x74, 0x65, 0x5F, 0x73, 0x6C, 0x69, 0x63,
+		0x65, 0x73, 0x22, 0x3A, 0x20, 0x30, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7D, 0x2C, 0x0A,
+		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6C, 0x6F, 0x63, 0x61, 0x6C, 0x5F, 0x73, 0x74, 0x61,
+		0x74, 0x65, 0x5F, 0x73, 0x63, 0x68, 0x65, 0x6D, 0x61, 0x22, 0x3A, 0x20, 0x7B, 0x0A, 0x20, 0x20,
+		0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6E, 0x75, 0x6D, 0x5F, 0x75, 0x69, 0x6E, 0x74, 0x73,
+		0x22, 0x3A, 0x20, 0x31, 0x36, 0x2C, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x22,
+		0x6E, 0x75, 0x6D, 0x5F, 0x62, 0x79, 0x74, 0x65, 0x5F, 0x73, 0x6C, 0x69, 0x63, 0x65, 0x73, 0x22,
+		0x3A, 0x20, 0x30, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7D, 0x2C, 0x0A, 0x20, 0x20, 0x20,
+		0x20, 0x20, 0x20, 0x22, 0x6E, 0x61, 0x6D, 0x65, 0x22, 0x3A, 0x20, 0x22, 0x76, 0x61, 0x6C, 0x69,
+		0x64, 0x61, 0x74, 0x6F, 0x72, 0x5F, 0x61, 0x70, 0x70, 0x22, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x7D,
+		0x0A, 0x20, 0x20, 0x7D, 0x0A, 0x7D,
+	},
 }
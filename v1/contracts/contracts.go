@@ -2,21 +2,140 @@ package contracts
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/algorand/go-algorand-sdk/crypto"
 
 	tTypes "github.com/synycboom/tinyman-go-sdk/types"
 	tUtils "github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
 )
 
 //go:generate ./bundle_asc_json.sh
 
-var asc tTypes.ASC
+var (
+	mu sync.RWMutex
+
+	// ascs are contracts keyed by version
+	ascs = make(map[string]*tTypes.ASC)
+
+	// versions are contracts versions keyed by validator app id
+	versions = make(map[uint64]string)
+)
 
 func init() {
+	for version, ascJson := range bundledASCJson {
+		if err := RegisterASC(version, ascJson); err != nil {
+			panic(err)
+		}
+	}
+
+	RegisterValidatorApp(constants.TestnetValidatorAppIdV1_0, constants.Version1_0)
+	RegisterValidatorApp(constants.MainnetValidatorAppIdV1_0, constants.Version1_0)
+	RegisterValidatorApp(constants.TestnetValidatorAppIdV1_1, constants.Version1_1)
+	RegisterValidatorApp(constants.MainnetValidatorAppIdV1_1, constants.Version1_1)
+}
+
+// RegisterASC registers contracts of a version from an ASC json, e.g. asc.json of tinyman-contracts-v1.
+// Every asc-v*.json of this package is bundled by bundle_asc_json.sh, contracts of a version which is not bundled
+// have to be registered before using its pools.
+func RegisterASC(version string, ascJson []byte) error {
+	var asc tTypes.ASC
 	if err := json.Unmarshal(ascJson, &asc); err != nil {
-		panic(err)
+		return err
 	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	ascs[version] = &asc
+
+	return nil
+}
+
+// RegisterValidatorApp registers a contracts version of a validator app, e.g. a validator app deployed on a private network.
+// Rejections of the validator app are decoded into SDK errors when the program counters of the version are known.
+func RegisterValidatorApp(validatorAppID uint64, version string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	versions[validatorAppID] = version
+	tUtils.UnregisterRejectionPCs(validatorAppID)
+	registerRejections(validatorAppID, version)
+}
+
+// UnregisterASC removes registered contracts of a version
+func UnregisterASC(version string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	delete(ascs, version)
+}
+
+// UnregisterValidatorApp removes a registered contracts version of a validator app
+func UnregisterValidatorApp(validatorAppID uint64) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	delete(versions, validatorAppID)
+	tUtils.UnregisterRejectionPCs(validatorAppID)
+}
+
+// Version returns a contracts version of a validator app, an unregistered validator app returns an error
+func Version(validatorAppID uint64) (string, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	version, ok := versions[validatorAppID]
+	if !ok {
+		return "", fmt.Errorf("validator app %d is not registered", validatorAppID)
+	}
+
+	return version, nil
+}
+
+// ASC returns contracts of a validator app
+func ASC(validatorAppID uint64) (*tTypes.ASC, error) {
+	version, err := Version(validatorAppID)
+	if err != nil {
+		return nil, err
+	}
+
+	mu.RLock()
+	defer mu.RUnlock()
+
+	asc, ok := ascs[version]
+	if !ok {
+		return nil, fmt.Errorf(
+			"contracts %s of validator app %d are not registered, bundle asc-%s.json with bundle_asc_json.sh or register it with RegisterASC",
+			version,
+			validatorAppID,
+			strings.Replace(version, ".", "_", 1),
+		)
+	}
+
+	return asc, nil
+}
+
+// LiquidityAssetUnitName returns a unit name of liquidity assets created by pools of a validator app
+func LiquidityAssetUnitName(validatorAppID uint64) string {
+	if version, _ := Version(validatorAppID); version == constants.Version1_0 {
+		return constants.LiquidityTokenUnitName
+	}
+
+	return constants.LiquidityAssetUnitName
+}
+
+// LiquidityAssetName returns a name of a liquidity asset which the pool logic signature expects on bootstrap
+func LiquidityAssetName(validatorAppID uint64, asset1UnitName, asset2UnitName string) string {
+	prefix := constants.LiquidityAssetNamePrefix
+	if version, _ := Version(validatorAppID); version == constants.Version1_0 {
+		prefix = constants.LiquidityTokenNamePrefix
+	}
+
+	return fmt.Sprintf("%s %s-%s", prefix, asset1UnitName, asset2UnitName)
 }
 
 // PoolLogicSigAccount creates a logic signature account of the pool
@@ -25,6 +144,11 @@ func PoolLogicSigAccount(validatorAppID, asset1ID, asset2ID uint64) (*crypto.Log
 		asset1ID, asset2ID = asset2ID, asset1ID
 	}
 
+	asc, err := ASC(validatorAppID)
+	if err != nil {
+		return nil, err
+	}
+
 	program, err := tUtils.Program(asc.Contracts.PoolLogicSig.Logic, map[string]uint64{
 		"validator_app_id": validatorAppID,
 		"asset_id_1":       asset1ID,
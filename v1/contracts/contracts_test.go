@@ -2,10 +2,21 @@ package contracts_test
 
 import (
 	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
 	"testing"
 
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/future"
+	"github.com/algorand/go-algorand-sdk/types"
+
+	tTypes "github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
 	"github.com/synycboom/tinyman-go-sdk/v1/constants"
 	"github.com/synycboom/tinyman-go-sdk/v1/contracts"
+	"github.com/synycboom/tinyman-go-sdk/v1/prepare"
 )
 
 var (
@@ -53,3 +64,142 @@ func TestGetPoolLogicV1_1Reversed(t *testing.T) {
 		t.Errorf("PoolLogicSigAccount returned wrong logic")
 	}
 }
+
+func TestGetPoolLogicV1_0(t *testing.T) {
+	if _, err := contracts.PoolLogicSigAccount(constants.MainnetValidatorAppIdV1_0, asset1ID, asset2ID); err == nil {
+		t.Fatalf("PoolLogicSigAccount should return an error when v1.0 contracts are not registered")
+	} else if !strings.Contains(err.Error(), "asc-v1_0.json") {
+		t.Errorf("An error of unregistered v1.0 contracts should name its asc json, got %s", err.Error())
+	}
+	if _, err := contracts.PoolLogicSigAccount(1001, asset1ID, asset2ID); err == nil {
+		t.Fatalf("PoolLogicSigAccount should return an error for an unregistered validator app")
+	}
+	if contracts.LiquidityAssetUnitName(constants.TestnetValidatorAppIdV1_0) != constants.LiquidityTokenUnitName {
+		t.Errorf("LiquidityAssetUnitName returned wrong unit name for v1.0")
+	}
+	if contracts.LiquidityAssetName(constants.MainnetValidatorAppIdV1_0, "USDC", "ALGO") != "TinymanPool1.0 USDC-ALGO" {
+		t.Errorf("LiquidityAssetName returned wrong name for v1.0")
+	}
+
+	ascJson, err := os.ReadFile("asc-v1_1.json")
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if err := contracts.RegisterASC(constants.Version1_0, ascJson); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	t.Cleanup(func() {
+		contracts.UnregisterASC(constants.Version1_0)
+	})
+
+	acc, err := contracts.PoolLogicSigAccount(constants.MainnetValidatorAppIdV1_0, asset1ID, asset2ID)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	poolAddress, err := acc.Address()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	user := crypto.GenerateAccount().Address.String()
+	sp := types.SuggestedParams{FirstRoundValid: 1, LastRoundValid: 1001, GenesisHash: make([]byte, 32), MinFee: 1000}
+	burn, err := prepare.BurnTransactions(constants.MainnetValidatorAppIdV1_0, asset1ID, asset2ID, 7, 100, 100, 100, user, sp)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	redeem, err := prepare.RedeemTransactions(constants.MainnetValidatorAppIdV1_0, asset1ID, asset2ID, 7, asset2ID, 100, user, sp)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	for _, txGroup := range []*utils.TransactionGroup{burn, redeem} {
+		call := txGroup.Transactions()[1]
+		if uint64(call.ApplicationID) != constants.MainnetValidatorAppIdV1_0 || call.Sender != poolAddress {
+			t.Errorf("A v1.0 app call should be sent by the v1.0 pool to the v1.0 validator app")
+		}
+	}
+}
+
+func TestRegisterASC(t *testing.T) {
+	ascJson, err := os.ReadFile("asc-v1_1.json")
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	customAppID := uint64(1001)
+	if err := contracts.RegisterASC("custom", ascJson); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	contracts.RegisterValidatorApp(customAppID, "custom")
+	t.Cleanup(func() {
+		contracts.UnregisterValidatorApp(customAppID)
+		contracts.UnregisterASC("custom")
+	})
+
+	if version, err := contracts.Version(customAppID); err != nil || version != "custom" {
+		t.Errorf("Version returned wrong version %s", version)
+	}
+	custom, err := contracts.PoolLogicSigAccount(customAppID, asset1ID, asset2ID)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	acc, err := contracts.PoolLogicSigAccount(appIDV, asset1ID, asset2ID)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if base64.StdEncoding.EncodeToString(custom.Lsig.Logic) == base64.StdEncoding.EncodeToString(acc.Lsig.Logic) {
+		t.Errorf("PoolLogicSigAccount should embed the validator app id")
+	}
+
+	user := crypto.GenerateAccount().Address.String()
+	sp := types.SuggestedParams{FirstRoundValid: 1, LastRoundValid: 1001, GenesisHash: make([]byte, 32), MinFee: 1000}
+	swap, err := prepare.SwapTransactions(customAppID, asset1ID, asset2ID, 7, asset1ID, 100, 90, constants.SwapFixedInput, user, sp)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if uint64(swap.Transactions()[1].ApplicationID) != customAppID {
+		t.Errorf("A swap of a custom validator app should call the custom validator app")
+	}
+}
+
+func TestValidatorRejections(t *testing.T) {
+	asc, err := contracts.ASC(constants.MainnetValidatorAppIdV1_1)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	program, err := base64.StdEncoding.DecodeString(asc.Contracts.ValidatorApp.ApprovalProgram.Bytecode)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	user := crypto.GenerateAccount().Address
+	sp := types.SuggestedParams{FirstRoundValid: 1, LastRoundValid: 1001, GenesisHash: make([]byte, 32), MinFee: 1000}
+	appCall, err := future.MakeApplicationNoOpTx(constants.MainnetValidatorAppIdV1_1, [][]byte{[]byte("swap")}, nil, nil, nil, sp, user, nil, types.Digest{}, [32]byte{}, types.Address{})
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	txID := crypto.GetTxID(appCall)
+
+	cases := []struct {
+		reason   string
+		pc       int
+		opcode   byte
+		expected error
+	}{
+		{"- would result negative. Details: pc=1070, opcodes=load 20\nload 53\n-\n", 1070, 0x09, tTypes.ErrSlippageExceeded},
+		{"- would result negative. Details: pc=986, opcodes=load 52\nload 21\n-\n", 986, 0x09, tTypes.ErrSlippageExceeded},
+		{"- would result negative. Details: pc=751, opcodes=gtxn 4 AssetAmount\n-\n", 751, 0x09, tTypes.ErrSlippageExceeded},
+		{"- would result negative. Details: pc=625, opcodes=load 52\n-\n", 625, 0x09, tTypes.ErrSlippageExceeded},
+		{"assert failed pc=1065", 1065, 0x44, tTypes.ErrInsufficientLiquidity},
+		{"assert failed pc=909", 909, 0x44, tTypes.ErrAssetMismatch},
+	}
+	for _, c := range cases {
+		if program[c.pc] != c.opcode {
+			t.Errorf("Program counter %d of the validator is not the expected opcode", c.pc)
+		}
+
+		message := fmt.Sprintf("TransactionPool.Remember: transaction %s: logic eval error: %s", txID, c.reason)
+		if err := utils.DecodeRejection(errors.New(message), []types.Transaction{appCall}); !errors.Is(err, c.expected) {
+			t.Errorf("DecodeRejection returned wrong error for %s: %v", message, err)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package contracts
+
+import (
+	tTypes "github.com/synycboom/tinyman-go-sdk/types"
+	tUtils "github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+)
+
+// rejections map program counters of validator approval programs to SDK errors keyed by contracts version.
+// The validator does not check slippage itself, an amount outside of the slippage bounds underflows a subtraction
+// ("- would result negative"), other checks fail an assert ("assert failed pc=N").
+var rejections = map[string]map[uint64]error{
+	constants.Version1_1: {
+		// the pool is not opted in to its assets or its liquidity asset
+		153: tTypes.ErrPoolNotBootstrapped,
+		189: tTypes.ErrPoolNotBootstrapped,
+		345: tTypes.ErrPoolNotBootstrapped,
+		559: tTypes.ErrPoolNotBootstrapped,
+		779: tTypes.ErrPoolNotBootstrapped,
+
+		// a transfer of an asset other than the pool expects
+		350: tTypes.ErrAssetMismatch,
+		587: tTypes.ErrAssetMismatch,
+		786: tTypes.ErrAssetMismatch,
+		792: tTypes.ErrAssetMismatch,
+		909: tTypes.ErrAssetMismatch,
+
+		// burn: both output amounts have to be positive
+		656: tTypes.ErrInsufficientLiquidity,
+
+		// swap: the output amount has to be positive and less than the output reserves
+		981:  tTypes.ErrInsufficientLiquidity,
+		1065: tTypes.ErrInsufficientLiquidity,
+
+		// burn: the calculated output amounts are less than the transferred amounts
+		625: tTypes.ErrSlippageExceeded,
+		648: tTypes.ErrSlippageExceeded,
+
+		// mint: the calculated liquidity is less than the transferred liquidity
+		751: tTypes.ErrSlippageExceeded,
+
+		// first mint: the transferred liquidity is not the square root of the product of the amounts minus the locked liquidity
+		815: tTypes.ErrSlippageExceeded,
+		821: tTypes.ErrSlippageExceeded,
+		846: tTypes.ErrSlippageExceeded,
+		852: tTypes.ErrSlippageExceeded,
+
+		// fixed-output swap: the calculated input amount is greater than the transferred amount
+		986: tTypes.ErrSlippageExceeded,
+
+		// fixed-input swap: the calculated output amount is less than the transferred amount
+		1070: tTypes.ErrSlippageExceeded,
+	},
+}
+
+// registerRejections registers rejections of a contracts version for a validator app
+func registerRejections(validatorAppID uint64, version string) {
+	for pc, err := range rejections[version] {
+		tUtils.RegisterRejectionPC(validatorAppID, pc, err)
+	}
+}
@@ -0,0 +1,546 @@
+package decode
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+	algoTypes "github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+	"github.com/synycboom/tinyman-go-sdk/v1/contracts"
+)
+
+// excessKeyLength is the length of an excess key, a pool address followed by 'e' and an asset id
+const excessKeyLength = len(algoTypes.Address{}) + 9
+
+// groupTxn is a transaction of a group with the asset id it created and its state changes, which are only known from a block
+type groupTxn struct {
+	txn            algoTypes.Transaction
+	createdAssetID uint64
+	delta          algoTypes.EvalDelta
+}
+
+// Decoder decodes blocks of a validator app in round order.
+// A block only carries new values of changed excess amounts, so the decoder keeps the excess amounts it has seen to tell how much a group added.
+// Excess amounts left before the first decoded block are taken as zero unless they are set by SetExcess.
+type Decoder struct {
+	validatorAppID uint64
+
+	// excess are excess amounts by user address and excess key
+	excess map[string]uint64
+
+	// changed are keys of excess whose amounts changed since the last ExcessChanges
+	changed map[string]bool
+}
+
+// ExcessAmount is an excess amount of a user in a pool
+type ExcessAmount struct {
+	// UserAddress is an address of the user
+	UserAddress string
+
+	// PoolAddress is an address of the pool
+	PoolAddress string
+
+	// AssetID is an asset id of the excess amount
+	AssetID uint64
+
+	// Amount is an excess amount, zero when it was redeemed
+	Amount uint64
+}
+
+// NewDecoder creates a decoder of a validator app which has not seen any excess amount
+func NewDecoder(validatorAppID uint64) *Decoder {
+	return &Decoder{
+		validatorAppID: validatorAppID,
+		excess:         make(map[string]uint64),
+		changed:        make(map[string]bool),
+	}
+}
+
+// SetExcess sets an excess amount of a user in a pool as it is before the next decoded block
+func (d *Decoder) SetExcess(userAddress, poolAddress string, assetID, amount uint64) error {
+	user, err := algoTypes.DecodeAddress(userAddress)
+	if err != nil {
+		return err
+	}
+
+	key, err := utils.ExcessAssetStateKey(poolAddress, assetID)
+	if err != nil {
+		return err
+	}
+
+	d.setExcess(user, string(key), amount)
+
+	return nil
+}
+
+// ExcessChanges returns excess amounts which changed since the last call sorted by user, pool and asset, a redeemed amount is zero.
+// Saving them after every block lets a new decoder continue from the saved amounts with SetExcess.
+func (d *Decoder) ExcessChanges() []ExcessAmount {
+	keys := make([]string, 0, len(d.changed))
+	for key := range d.changed {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	changes := make([]ExcessAmount, 0, len(keys))
+	for _, key := range keys {
+		var user, pool algoTypes.Address
+		copy(user[:], key)
+		copy(pool[:], key[len(user):])
+		changes = append(changes, ExcessAmount{
+			UserAddress: user.String(),
+			PoolAddress: pool.String(),
+			AssetID:     binary.BigEndian.Uint64([]byte(key[len(key)-8:])),
+			Amount:      d.excess[key],
+		})
+	}
+	d.changed = make(map[string]bool)
+
+	return changes
+}
+
+// Block decodes Tinyman groups of a validator app in a confirmed block with a new decoder, see Decoder.Block
+func Block(validatorAppID uint64, block algoTypes.Block) ([]types.Action, error) {
+	return NewDecoder(validatorAppID).Block(block)
+}
+
+// Block decodes Tinyman groups of the validator app in a confirmed block in block order,
+// groups calling the validator app which cannot be decoded are returned as *types.UnknownEvent.
+// Swaps, mints and burns report executed amounts, which include the excess their groups added.
+func (d *Decoder) Block(block algoTypes.Block) ([]types.Action, error) {
+	txns := make([]groupTxn, len(block.Payset))
+	for idx, stib := range block.Payset {
+		txn := stib.Txn
+		// a block omits the genesis of its transactions, it is restored so that transaction ids match
+		if stib.HasGenesisID {
+			txn.GenesisID = block.GenesisID
+		}
+		if stib.HasGenesisHash {
+			txn.GenesisHash = block.GenesisHash
+		}
+
+		txns[idx] = groupTxn{txn: txn, createdAssetID: stib.ConfigAsset, delta: stib.EvalDelta}
+	}
+
+	return d.decode(uint64(block.Round), txns)
+}
+
+// Transactions decodes Tinyman groups of a validator app in a list of transactions confirmed at a round,
+// consecutive transactions with the same group id form a group and other groups are skipped.
+// Transactions carry no state changes, so swaps, mints and burns report transferred amounts, which exclude any excess.
+func Transactions(validatorAppID, round uint64, stxns []algoTypes.SignedTxn) ([]types.Action, error) {
+	txns := make([]groupTxn, len(stxns))
+	for idx, stxn := range stxns {
+		txns[idx] = groupTxn{txn: stxn.Txn}
+	}
+
+	return NewDecoder(validatorAppID).decode(round, txns)
+}
+
+// Group decodes a transaction group, it returns nil when the group is not a Tinyman group of the validator app.
+// Like Transactions, swaps, mints and burns report transferred amounts.
+func Group(validatorAppID, round uint64, txns []algoTypes.Transaction) (types.Action, error) {
+	group := make([]groupTxn, len(txns))
+	for idx, txn := range txns {
+		group[idx] = groupTxn{txn: txn}
+	}
+
+	return NewDecoder(validatorAppID).decodeGroup(round, group)
+}
+
+// decode splits transactions into groups and decodes them
+func (d *Decoder) decode(round uint64, txns []groupTxn) ([]types.Action, error) {
+	var actions []types.Action
+	for start := 0; start < len(txns); {
+		end := start + 1
+		groupID := txns[start].txn.Group
+		if groupID != (algoTypes.Digest{}) {
+			for end < len(txns) && txns[end].txn.Group == groupID {
+				end++
+			}
+		}
+
+		action, err := d.decodeGroup(round, txns[start:end])
+		if err != nil {
+			return nil, err
+		}
+		if action != nil {
+			actions = append(actions, action)
+		}
+
+		start = end
+	}
+
+	return actions, nil
+}
+
+// decodeGroup decodes a group by the first argument of its validator app call, which is always the second transaction.
+// A group calling the validator app which cannot be decoded is returned as an UnknownEvent, so one odd group does not fail a whole block.
+func (d *Decoder) decodeGroup(round uint64, txns []groupTxn) (types.Action, error) {
+	if len(txns) < 3 {
+		return nil, nil
+	}
+
+	call := txns[1].txn
+	if call.Type != algoTypes.ApplicationCallTx || uint64(call.ApplicationID) != d.validatorAppID || len(call.ApplicationArgs) == 0 {
+		return nil, nil
+	}
+
+	added := d.applyExcess(txns[1])
+	action, err := decodeCall(d.validatorAppID, round, txns, added)
+	if err != nil {
+		return unknownEvent(d.validatorAppID, round, txns, err), nil
+	}
+
+	return action, nil
+}
+
+// applyExcess applies excess changes of a validator app call and returns amounts it added to excess of the user in the calling pool by asset id
+func (d *Decoder) applyExcess(call groupTxn) map[uint64]uint64 {
+	added := make(map[uint64]uint64)
+	accounts := append([]algoTypes.Address{call.txn.Sender}, call.txn.Accounts...)
+	for offset, delta := range call.delta.LocalDeltas {
+		if offset >= uint64(len(accounts)) {
+			continue
+		}
+
+		account := accounts[offset]
+		for key, value := range delta {
+			if len(key) != excessKeyLength || key[len(algoTypes.Address{})] != 'e' {
+				continue
+			}
+
+			var amount uint64
+			if value.Action == algoTypes.SetUintAction {
+				amount = value.Uint
+			}
+
+			previous := d.excess[string(account[:])+key]
+			d.setExcess(account, key, amount)
+
+			// the user is the first account of the call, which the pool sends
+			if offset == 1 && key[:len(algoTypes.Address{})] == string(call.txn.Sender[:]) && amount > previous {
+				added[binary.BigEndian.Uint64([]byte(key[len(key)-8:]))] += amount - previous
+			}
+		}
+	}
+
+	return added
+}
+
+func (d *Decoder) setExcess(account algoTypes.Address, key string, amount uint64) {
+	d.changed[string(account[:])+key] = true
+	if amount == 0 {
+		delete(d.excess, string(account[:])+key)
+
+		return
+	}
+
+	d.excess[string(account[:])+key] = amount
+}
+
+// decodeCall decodes a group calling the validator app by its operation, added are excess amounts the group added by asset id
+func decodeCall(validatorAppID, round uint64, txns []groupTxn, added map[uint64]uint64) (types.Action, error) {
+	call := txns[1].txn
+	op := string(call.ApplicationArgs[0])
+	switch op {
+	case constants.ActionBootstrap:
+		return decodeBootstrap(validatorAppID, round, txns)
+	case constants.ActionSwap, constants.ActionMint, constants.ActionBurn, constants.ActionRedeem, constants.ActionFees:
+	default:
+		return nil, fmt.Errorf("group %s has an unknown operation '%s'", groupID(call), op)
+	}
+
+	// every other operation passes asset1, asset2 unless it is ALGO, and the liquidity asset as foreign assets
+	var asset1ID, asset2ID, liquidityAssetID uint64
+	switch len(call.ForeignAssets) {
+	case 2:
+		asset1ID, liquidityAssetID = uint64(call.ForeignAssets[0]), uint64(call.ForeignAssets[1])
+	case 3:
+		asset1ID, asset2ID, liquidityAssetID = uint64(call.ForeignAssets[0]), uint64(call.ForeignAssets[1]), uint64(call.ForeignAssets[2])
+	default:
+		return nil, fmt.Errorf("%s group %s has %d foreign assets", op, groupID(call), len(call.ForeignAssets))
+	}
+
+	event, err := poolEvent(op, validatorAppID, round, asset1ID, asset2ID, txns)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case constants.ActionSwap:
+		return decodeSwap(event, txns, added)
+	case constants.ActionMint:
+		return decodeMint(event, liquidityAssetID, txns, added)
+	case constants.ActionBurn:
+		return decodeBurn(event, liquidityAssetID, txns, added)
+	case constants.ActionRedeem:
+		return decodeRedeem(event, txns)
+	case constants.ActionFees:
+		return decodeFees(event, liquidityAssetID, txns)
+	}
+
+	return nil, nil
+}
+
+// unknownEvent returns an event of a group calling the validator app which could not be decoded
+func unknownEvent(validatorAppID, round uint64, txns []groupTxn, err error) *types.UnknownEvent {
+	call := txns[1].txn
+
+	return &types.UnknownEvent{
+		PoolEvent: types.PoolEvent{
+			Type:           constants.ActionUnknown,
+			Round:          round,
+			TxID:           crypto.TransactionIDString(call),
+			GroupID:        groupID(call),
+			Sender:         txns[0].txn.Sender.String(),
+			PoolAddress:    call.Sender.String(),
+			ValidatorAppID: validatorAppID,
+		},
+		Operation: string(call.ApplicationArgs[0]),
+		Reason:    err.Error(),
+	}
+}
+
+// poolEvent resolves the pool of a group and checks that the pool sent its validator app call
+func poolEvent(op string, validatorAppID, round, asset1ID, asset2ID uint64, txns []groupTxn) (types.PoolEvent, error) {
+	call := txns[1].txn
+	poolAccount, err := contracts.PoolLogicSigAccount(validatorAppID, asset1ID, asset2ID)
+	if err != nil {
+		return types.PoolEvent{}, err
+	}
+
+	poolAddress, err := poolAccount.Address()
+	if err != nil {
+		return types.PoolEvent{}, err
+	}
+	if call.Sender != poolAddress {
+		return types.PoolEvent{}, fmt.Errorf("%s group %s is not sent by the pool of assets %d and %d", op, groupID(call), asset1ID, asset2ID)
+	}
+
+	return types.PoolEvent{
+		Type:           op,
+		Round:          round,
+		TxID:           crypto.TransactionIDString(call),
+		GroupID:        groupID(call),
+		Sender:         txns[0].txn.Sender.String(),
+		PoolAddress:    poolAddress.String(),
+		ValidatorAppID: validatorAppID,
+		Asset1ID:       asset1ID,
+		Asset2ID:       asset2ID,
+	}, nil
+}
+
+// decodeBootstrap decodes a funding payment, an opt-in call with the asset ids as arguments, a liquidity asset creation and asset opt-ins
+func decodeBootstrap(validatorAppID, round uint64, txns []groupTxn) (types.Action, error) {
+	call := txns[1].txn
+	if len(call.ApplicationArgs) != 3 || len(txns) < 4 {
+		return nil, fmt.Errorf("bootstrap group %s has a wrong shape", groupID(call))
+	}
+
+	asset1ID, err := utils.BytesToInt(call.ApplicationArgs[1])
+	if err != nil {
+		return nil, err
+	}
+	asset2ID, err := utils.BytesToInt(call.ApplicationArgs[2])
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := poolEvent(constants.ActionBootstrap, validatorAppID, round, asset1ID, asset2ID, txns)
+	if err != nil {
+		return nil, err
+	}
+
+	_, funding, err := transfer(txns[0].txn, txns[0].txn.Sender, call.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BootstrapEvent{
+		PoolEvent:        event,
+		FundingAmount:    funding,
+		LiquidityAssetID: txns[2].createdAssetID,
+	}, nil
+}
+
+// decodeSwap decodes a fee payment, the swap call, a transfer in and a transfer out.
+// A fixed input swap leaves output above the minimum as excess and a fixed output swap leaves unused input as excess.
+func decodeSwap(event types.PoolEvent, txns []groupTxn, added map[uint64]uint64) (types.Action, error) {
+	if len(txns) != 4 {
+		return nil, fmt.Errorf("swap group %s has %d transactions", event.GroupID, len(txns))
+	}
+
+	call := txns[1].txn
+	if len(call.ApplicationArgs) != 2 {
+		return nil, fmt.Errorf("swap group %s has no swap type", event.GroupID)
+	}
+
+	var swapType string
+	for name, arg := range constants.SwapTypeMapping {
+		if bytes.Equal(call.ApplicationArgs[1], []byte(arg)) {
+			swapType = name
+		}
+	}
+	if len(swapType) == 0 {
+		return nil, fmt.Errorf("swap group %s has an unknown swap type '%s'", event.GroupID, call.ApplicationArgs[1])
+	}
+
+	user := txns[0].txn.Sender
+	assetInID, amountIn, err := transfer(txns[2].txn, user, call.Sender)
+	if err != nil {
+		return nil, err
+	}
+	assetOutID, amountOut, err := transfer(txns[3].txn, call.Sender, user)
+	if err != nil {
+		return nil, err
+	}
+
+	var excess uint64
+	if swapType == constants.SwapFixedInput {
+		excess = added[assetOutID]
+		amountOut += excess
+	} else {
+		excess = added[assetInID]
+		if excess > amountIn {
+			return nil, fmt.Errorf("swap group %s refunds %d of %d sent", event.GroupID, excess, amountIn)
+		}
+		amountIn -= excess
+	}
+
+	return &types.SwapEvent{
+		PoolEvent:  event,
+		SwapType:   swapType,
+		AssetInID:  assetInID,
+		AmountIn:   amountIn,
+		AssetOutID: assetOutID,
+		AmountOut:  amountOut,
+		Excess:     excess,
+	}, nil
+}
+
+// decodeMint decodes a fee payment, the mint call, asset1 and asset2 transfers in and a liquidity asset transfer out
+func decodeMint(event types.PoolEvent, liquidityAssetID uint64, txns []groupTxn, added map[uint64]uint64) (types.Action, error) {
+	if len(txns) != 5 {
+		return nil, fmt.Errorf("mint group %s has %d transactions", event.GroupID, len(txns))
+	}
+
+	user, pool := txns[0].txn.Sender, txns[1].txn.Sender
+	amounts, err := transfers(txns[2:], []algoTypes.Address{user, user, pool}, []algoTypes.Address{pool, pool, user})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MintEvent{
+		PoolEvent:            event,
+		Asset1Amount:         amounts[0],
+		Asset2Amount:         amounts[1],
+		LiquidityAssetID:     liquidityAssetID,
+		LiquidityAssetAmount: amounts[2] + added[liquidityAssetID],
+		LiquidityAssetExcess: added[liquidityAssetID],
+	}, nil
+}
+
+// decodeBurn decodes a fee payment, the burn call, asset1 and asset2 transfers out and a liquidity asset transfer in
+func decodeBurn(event types.PoolEvent, liquidityAssetID uint64, txns []groupTxn, added map[uint64]uint64) (types.Action, error) {
+	if len(txns) != 5 {
+		return nil, fmt.Errorf("burn group %s has %d transactions", event.GroupID, len(txns))
+	}
+
+	user, pool := txns[0].txn.Sender, txns[1].txn.Sender
+	amounts, err := transfers(txns[2:], []algoTypes.Address{pool, pool, user}, []algoTypes.Address{user, user, pool})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BurnEvent{
+		PoolEvent:            event,
+		Asset1Amount:         amounts[0] + added[event.Asset1ID],
+		Asset2Amount:         amounts[1] + added[event.Asset2ID],
+		Asset1Excess:         added[event.Asset1ID],
+		Asset2Excess:         added[event.Asset2ID],
+		LiquidityAssetID:     liquidityAssetID,
+		LiquidityAssetAmount: amounts[2],
+	}, nil
+}
+
+// decodeRedeem decodes a fee payment, the redeem call and a transfer out
+func decodeRedeem(event types.PoolEvent, txns []groupTxn) (types.Action, error) {
+	if len(txns) != 3 {
+		return nil, fmt.Errorf("redeem group %s has %d transactions", event.GroupID, len(txns))
+	}
+
+	assetID, amount, err := transfer(txns[2].txn, txns[1].txn.Sender, txns[0].txn.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RedeemEvent{
+		PoolEvent: event,
+		AssetID:   assetID,
+		Amount:    amount,
+	}, nil
+}
+
+// decodeFees decodes a fee payment, the fees call and a liquidity asset transfer out of the pool
+func decodeFees(event types.PoolEvent, liquidityAssetID uint64, txns []groupTxn) (types.Action, error) {
+	if len(txns) != 3 {
+		return nil, fmt.Errorf("fees group %s has %d transactions", event.GroupID, len(txns))
+	}
+
+	claim := txns[2].txn
+	_, amount, err := transfer(claim, txns[1].txn.Sender, claim.AssetReceiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.FeesEvent{
+		PoolEvent:        event,
+		LiquidityAssetID: liquidityAssetID,
+		Amount:           amount,
+		Receiver:         claim.AssetReceiver.String(),
+	}, nil
+}
+
+// transfers returns amounts of transfers between given senders and receivers
+func transfers(txns []groupTxn, senders, receivers []algoTypes.Address) ([]uint64, error) {
+	amounts := make([]uint64, len(senders))
+	for idx := range senders {
+		_, amount, err := transfer(txns[idx].txn, senders[idx], receivers[idx])
+		if err != nil {
+			return nil, err
+		}
+
+		amounts[idx] = amount
+	}
+
+	return amounts, nil
+}
+
+// transfer returns the asset id and the amount of a payment or an asset transfer between a sender and a receiver, ALGO is asset 0
+func transfer(txn algoTypes.Transaction, sender, receiver algoTypes.Address) (uint64, uint64, error) {
+	switch txn.Type {
+	case algoTypes.PaymentTx:
+		if txn.Sender == sender && txn.Receiver == receiver {
+			return 0, uint64(txn.Amount), nil
+		}
+	case algoTypes.AssetTransferTx:
+		if txn.Sender == sender && txn.AssetReceiver == receiver {
+			return uint64(txn.XferAsset), txn.AssetAmount, nil
+		}
+	}
+
+	return 0, 0, fmt.Errorf("transaction %s is not a transfer from %s to %s", crypto.TransactionIDString(txn), sender.String(), receiver.String())
+}
+
+// groupID returns a base64 encoded group id of a transaction
+func groupID(txn algoTypes.Transaction) string {
+	return base64.StdEncoding.EncodeToString(txn.Group[:])
+}
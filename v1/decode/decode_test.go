@@ -0,0 +1,213 @@
+package decode_test
+
+import (
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/future"
+	algoTypes "github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+	"github.com/synycboom/tinyman-go-sdk/v1/decode"
+	"github.com/synycboom/tinyman-go-sdk/v1/prepare"
+	"github.com/synycboom/tinyman-go-sdk/v1/tinymantest"
+)
+
+const (
+	validatorAppID   = constants.TestnetValidatorAppId
+	usdcID           = uint64(10458941)
+	liquidityAssetID = uint64(62368708)
+)
+
+func TestDecode(t *testing.T) {
+	user := crypto.GenerateAccount().Address.String()
+	sp := algoTypes.SuggestedParams{
+		GenesisID:       "testnet-v1.0",
+		GenesisHash:     make([]byte, 32),
+		FirstRoundValid: 1,
+		LastRoundValid:  1001,
+		MinFee:          1000,
+	}
+
+	var groups []*utils.TransactionGroup
+	add := func(txGroup *utils.TransactionGroup, err error) {
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		groups = append(groups, txGroup)
+	}
+	add(prepare.BootstrapTransactions(validatorAppID, usdcID, 0, "USDC", "ALGO", user, sp))
+	add(prepare.SwapTransactions(validatorAppID, usdcID, 0, liquidityAssetID, 0, 1000000, 1990000, constants.SwapFixedInput, user, sp))
+	add(prepare.MintTransactions(validatorAppID, usdcID, 0, liquidityAssetID, 2000000, 1000000, 1400000, user, sp))
+	payment, err := future.MakePaymentTxn(user, user, 1, nil, "", sp)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	add(utils.NewTransactionGroup([]algoTypes.Transaction{payment}))
+	add(prepare.BurnTransactions(validatorAppID, usdcID, 0, liquidityAssetID, 2000000, 1000000, 1400000, user, sp))
+	add(prepare.RedeemTransactions(validatorAppID, usdcID, 0, liquidityAssetID, usdcID, 17, user, sp))
+
+	// a swap group without its transfer out cannot be decoded and must not hide the other groups
+	truncated := groups[1].Transactions()[:3]
+
+	var stxns []algoTypes.SignedTxn
+	block := algoTypes.Block{}
+	block.Round = 42
+	block.GenesisID = sp.GenesisID
+	copy(block.GenesisHash[:], sp.GenesisHash)
+	for _, txns := range append(transactions(groups), truncated) {
+		for _, txn := range txns {
+			stxns = append(stxns, algoTypes.SignedTxn{Txn: txn})
+
+			stripped := txn
+			stripped.GenesisID = ""
+			stripped.GenesisHash = algoTypes.Digest{}
+			stib := algoTypes.SignedTxnInBlock{HasGenesisID: true, HasGenesisHash: true}
+			stib.Txn = stripped
+			if txn.Type == algoTypes.AssetConfigTx {
+				stib.ConfigAsset = liquidityAssetID
+			}
+			block.Payset = append(block.Payset, stib)
+		}
+	}
+
+	actions, err := decode.Transactions(validatorAppID, 42, stxns)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	blockActions, err := decode.Block(validatorAppID, block)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(actions) != 6 || len(blockActions) != 6 {
+		t.Fatalf("Expected 6 Tinyman groups, got %d and %d", len(actions), len(blockActions))
+	}
+
+	unknown, ok := actions[5].(*types.UnknownEvent)
+	if !ok || unknown.Operation != constants.ActionSwap || unknown.Type != constants.ActionUnknown || len(unknown.Reason) == 0 {
+		t.Fatalf("An undecodable group should be an unknown event, got %v", actions[5])
+	}
+	actions = actions[:5]
+
+	wantTypes := []string{constants.ActionBootstrap, constants.ActionSwap, constants.ActionMint, constants.ActionBurn, constants.ActionRedeem}
+	poolAddress := groups[0].Transactions()[1].Sender.String()
+	for idx, action := range actions {
+		event := action.Event()
+		if event.Type != wantTypes[idx] || event.Round != 42 || event.Sender != user || event.PoolAddress != poolAddress {
+			t.Fatalf("Unexpected event %v", event)
+		}
+		if event.Asset1ID != usdcID || event.Asset2ID != 0 {
+			t.Fatalf("Unexpected pool assets %d and %d", event.Asset1ID, event.Asset2ID)
+		}
+		if blockActions[idx].Event().TxID != event.TxID {
+			t.Errorf("A block transaction id should match, got %s instead of %s", blockActions[idx].Event().TxID, event.TxID)
+		}
+	}
+
+	if bootstrap := blockActions[0].(*types.BootstrapEvent); bootstrap.LiquidityAssetID != liquidityAssetID || bootstrap.FundingAmount != constants.BootstrapTransactionAmountForAlgo {
+		t.Errorf("Unexpected bootstrap %v", bootstrap)
+	}
+
+	swap := actions[1].(*types.SwapEvent)
+	if swap.SwapType != constants.SwapFixedInput || swap.AssetInID != 0 || swap.AmountIn != 1000000 || swap.AssetOutID != usdcID || swap.AmountOut != 1990000 {
+		t.Errorf("Unexpected swap %v", swap)
+	}
+
+	mint := actions[2].(*types.MintEvent)
+	if mint.Asset1Amount != 2000000 || mint.Asset2Amount != 1000000 || mint.LiquidityAssetID != liquidityAssetID || mint.LiquidityAssetAmount != 1400000 {
+		t.Errorf("Unexpected mint %v", mint)
+	}
+
+	burn := actions[3].(*types.BurnEvent)
+	if burn.Asset1Amount != 2000000 || burn.Asset2Amount != 1000000 || burn.LiquidityAssetAmount != 1400000 {
+		t.Errorf("Unexpected burn %v", burn)
+	}
+
+	redeem := actions[4].(*types.RedeemEvent)
+	if redeem.AssetID != usdcID || redeem.Amount != 17 {
+		t.Errorf("Unexpected redeem %v", redeem)
+	}
+}
+
+func TestDecodeExecutedAmounts(t *testing.T) {
+	e := tinymantest.New(t)
+	e.OptIn()
+	pool := e.CreatePool(e.CreateAsset("AAA"), e.CreateAsset("BBB"), 50000000, 20000000)
+	poolAddress, err := pool.Address()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	decoder := decode.NewDecoder(e.Sim.ValidatorAppID())
+
+	quote, err := pool.FetchFixedInputSwapQuote(e.Ctx, &types.AssetAmount{Asset: pool.Asset1, Amount: 1000000}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	minAmountOut, err := quote.AmountOutWithSlippage()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	swapActions := submitAndDecode(t, e, decoder, func() (*utils.TransactionGroup, error) {
+		return pool.PrepareSwapTransactionsFromQuote(e.Ctx, quote, "")
+	})
+	swap := swapActions[0].(*types.SwapEvent)
+	if swap.Excess == 0 || swap.AmountIn != 1000000 || swap.AmountOut != quote.AmountOut.Amount || swap.Excess != quote.AmountOut.Amount-minAmountOut.Amount {
+		t.Errorf("Unexpected swap %v", swap)
+	}
+	if excess := e.Sim.Excess(e.Address(), poolAddress, pool.Asset2.ID); excess != swap.Excess {
+		t.Errorf("Expect excess %d but got %d", excess, swap.Excess)
+	}
+
+	e.Refresh(pool)
+	burnQuote, err := pool.FetchBurnQuote(e.Ctx, &types.AssetAmount{Asset: pool.LiquidityAsset, Amount: 1000000}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	burnActions := submitAndDecode(t, e, decoder, func() (*utils.TransactionGroup, error) {
+		return pool.PrepareBurnTransactionsFromQuote(e.Ctx, burnQuote, "")
+	})
+	burn := burnActions[0].(*types.BurnEvent)
+	if burn.Asset2Excess == 0 || burn.Asset1Amount != burnQuote.AmountsOut[pool.Asset1.ID].Amount || burn.Asset2Amount != burnQuote.AmountsOut[pool.Asset2.ID].Amount {
+		t.Errorf("Unexpected burn %v", burn)
+	}
+	// the swap excess was left before the burn, only the amount the burn added is its excess
+	if excess := e.Sim.Excess(e.Address(), poolAddress, pool.Asset2.ID); excess != swap.Excess+burn.Asset2Excess {
+		t.Errorf("Expect excess %d but got %d", excess, swap.Excess+burn.Asset2Excess)
+	}
+}
+
+// submitAndDecode submits a group and decodes the block of its round
+func submitAndDecode(t *testing.T, e *tinymantest.Fixture, decoder *decode.Decoder, prepare func() (*utils.TransactionGroup, error)) []types.Action {
+	status, err := e.Sim.Status(e.Ctx)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	e.Submit(prepare())
+	block, err := e.Sim.Block(e.Ctx, status.LastRound)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	actions, err := decoder.Block(block)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(actions) != 1 {
+		t.Fatalf("Expect 1 action but got %d", len(actions))
+	}
+
+	return actions
+}
+
+func transactions(groups []*utils.TransactionGroup) [][]algoTypes.Transaction {
+	txns := make([][]algoTypes.Transaction, len(groups))
+	for idx, txGroup := range groups {
+		txns[idx] = txGroup.Transactions()
+	}
+
+	return txns
+}
@@ -0,0 +1,299 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/v1/decode"
+)
+
+var (
+	// metaBucket holds the last processed round
+	metaBucket = []byte("meta")
+
+	// actionsBucket holds action records keyed by round and position in the round
+	actionsBucket = []byte("actions")
+
+	// poolActionsBucket holds keys of actionsBucket keyed by pool address, round and position in the round
+	poolActionsBucket = []byte("pool_actions")
+
+	// snapshotsBucket holds snapshots keyed by pool address and round
+	snapshotsBucket = []byte("snapshots")
+
+	// poolsBucket holds the last snapshot of every pool keyed by pool address
+	poolsBucket = []byte("pools")
+
+	// excessBucket holds excess amounts keyed by user address, pool address and asset id
+	excessBucket = []byte("excess")
+
+	lastRoundKey = []byte("last_round")
+)
+
+// openTimeout is how long opening waits for another process to release the database file
+const openTimeout = 5 * time.Second
+
+// FileStore is an embedded Storage in a bbolt database file.
+// Actions are keyed by round and by pool, so queries read only the matching range instead of loading the whole file,
+// and every round is committed and synced to disk in one transaction, so a crash never leaves a partial round.
+type FileStore struct {
+	db *bolt.DB
+}
+
+var _ Storage = (*FileStore)(nil)
+
+// OpenFileStore opens or creates a file storage at a given path
+func OpenFileStore(path string) (*FileStore, error) {
+	db, err := bolt.Open(path, 0o644, &bolt.Options{Timeout: openTimeout})
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{metaBucket, actionsBucket, poolActionsBucket, snapshotsBucket, poolsBucket, excessBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		db.Close()
+
+		return nil, err
+	}
+
+	return &FileStore{db: db}, nil
+}
+
+// SaveRound stores a round and marks it as processed in one synced transaction, an empty round only moves the last processed round
+func (s *FileStore) SaveRound(ctx context.Context, round *Round) error {
+	records := make([][]byte, len(round.Actions))
+	for idx, action := range round.Actions {
+		encoded, err := encodeAction(action)
+		if err != nil {
+			return err
+		}
+
+		records[idx], err = json.Marshal(encoded)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		meta := tx.Bucket(metaBucket)
+		if lastRound := decodeRound(meta.Get(lastRoundKey)); round.Round <= lastRound {
+			return fmt.Errorf("round %d is not after the last processed round %d", round.Round, lastRound)
+		}
+
+		actions, poolActions, snapshots := tx.Bucket(actionsBucket), tx.Bucket(poolActionsBucket), tx.Bucket(snapshotsBucket)
+		pools, excessAmounts := tx.Bucket(poolsBucket), tx.Bucket(excessBucket)
+		for idx, action := range round.Actions {
+			key := actionKey(round.Round, uint32(idx))
+			if err := actions.Put(key, records[idx]); err != nil {
+				return err
+			}
+			if err := poolActions.Put(poolKey(action.Event().PoolAddress, key), key); err != nil {
+				return err
+			}
+		}
+		for _, snapshot := range round.Snapshots {
+			data, err := json.Marshal(snapshot)
+			if err != nil {
+				return err
+			}
+			if err := snapshots.Put(poolKey(snapshot.Address, encodeRound(snapshot.Round)), data); err != nil {
+				return err
+			}
+			if err := pools.Put([]byte(snapshot.Address), data); err != nil {
+				return err
+			}
+		}
+		for _, excess := range round.Excess {
+			key := poolKey(excess.UserAddress, poolKey(excess.PoolAddress, encodeRound(excess.AssetID)))
+			if excess.Amount == 0 {
+				if err := excessAmounts.Delete(key); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			data, err := json.Marshal(excess)
+			if err != nil {
+				return err
+			}
+			if err := excessAmounts.Put(key, data); err != nil {
+				return err
+			}
+		}
+
+		return meta.Put(lastRoundKey, encodeRound(round.Round))
+	})
+}
+
+// LastRound returns the last processed round
+func (s *FileStore) LastRound(ctx context.Context) (uint64, error) {
+	var lastRound uint64
+	err := s.db.View(func(tx *bolt.Tx) error {
+		lastRound = decodeRound(tx.Bucket(metaBucket).Get(lastRoundKey))
+
+		return nil
+	})
+
+	return lastRound, err
+}
+
+// Actions returns stored actions matching a filter in round order, a filter by pool only reads actions of the pool
+func (s *FileStore) Actions(ctx context.Context, filter *Filter) ([]types.Action, error) {
+	var fromRound, toRound uint64
+	var poolAddress string
+	if filter != nil {
+		fromRound, toRound, poolAddress = filter.FromRound, filter.ToRound, filter.PoolAddress
+	}
+
+	var actions []types.Action
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(actionsBucket)
+		var prefix []byte
+		if len(poolAddress) > 0 {
+			bucket = tx.Bucket(poolActionsBucket)
+			prefix = poolKey(poolAddress, nil)
+		}
+
+		records := tx.Bucket(actionsBucket)
+		c := bucket.Cursor()
+		for k, v := c.Seek(poolKey(poolAddress, encodeRound(fromRound))); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			if toRound > 0 && decodeRound(k[len(prefix):]) > toRound {
+				break
+			}
+			if len(prefix) > 0 {
+				v = records.Get(v)
+			}
+
+			var record actionRecord
+			if err := json.Unmarshal(v, &record); err != nil {
+				return err
+			}
+
+			action, err := decodeAction(record)
+			if err != nil {
+				return err
+			}
+			if filter.match(action) {
+				actions = append(actions, action)
+			}
+		}
+
+		return nil
+	})
+
+	return actions, err
+}
+
+// Snapshots returns stored snapshots of a pool read between two rounds inclusive in round order
+func (s *FileStore) Snapshots(ctx context.Context, poolAddress string, fromRound, toRound uint64) ([]types.PoolInfo, error) {
+	var snapshots []types.PoolInfo
+	err := s.db.View(func(tx *bolt.Tx) error {
+		prefix := poolKey(poolAddress, nil)
+		c := tx.Bucket(snapshotsBucket).Cursor()
+		for k, v := c.Seek(poolKey(poolAddress, encodeRound(fromRound))); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			if decodeRound(k[len(prefix):]) > toRound {
+				break
+			}
+
+			var snapshot types.PoolInfo
+			if err := json.Unmarshal(v, &snapshot); err != nil {
+				return err
+			}
+
+			snapshots = append(snapshots, snapshot)
+		}
+
+		return nil
+	})
+
+	return snapshots, err
+}
+
+// State returns the excess amounts and the last snapshot of every pool
+func (s *FileStore) State(ctx context.Context) (*State, error) {
+	state := &State{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		err := tx.Bucket(excessBucket).ForEach(func(k, v []byte) error {
+			var excess decode.ExcessAmount
+			if err := json.Unmarshal(v, &excess); err != nil {
+				return err
+			}
+
+			state.Excess = append(state.Excess, excess)
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return tx.Bucket(poolsBucket).ForEach(func(k, v []byte) error {
+			var info types.PoolInfo
+			if err := json.Unmarshal(v, &info); err != nil {
+				return err
+			}
+
+			state.Pools = append(state.Pools, info)
+
+			return nil
+		})
+	})
+
+	return state, err
+}
+
+// Close closes the database file
+func (s *FileStore) Close() error {
+	return s.db.Close()
+}
+
+// poolKey prefixes a key by a pool address and a separator, so that a pool address is never a prefix of another
+func poolKey(poolAddress string, key []byte) []byte {
+	if len(poolAddress) == 0 {
+		return key
+	}
+
+	prefixed := append([]byte(poolAddress), 0)
+
+	return append(prefixed, key...)
+}
+
+// actionKey returns a key of an action which sorts by round and position in the round
+func actionKey(round uint64, idx uint32) []byte {
+	key := make([]byte, 12)
+	binary.BigEndian.PutUint64(key, round)
+	binary.BigEndian.PutUint32(key[8:], idx)
+
+	return key
+}
+
+func encodeRound(round uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, round)
+
+	return key
+}
+
+// decodeRound decodes a round from the first 8 bytes of a key, a missing value is round zero
+func decodeRound(key []byte) uint64 {
+	if len(key) < 8 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(key)
+}
@@ -0,0 +1,178 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/v1/decode"
+)
+
+// Indexer scans blocks for Tinyman groups of a validator app and stores them round by round.
+// Algorand blocks are final once confirmed, so a stored round never has to be revisited and indexing resumes after the last stored round.
+// A single decoder follows excess amounts across indexed rounds, excess left before the first indexed round is taken as zero.
+// Changed excess amounts and pool states are saved with every round, so an indexer created on the same storage resumes with them.
+type Indexer struct {
+	ac      types.AlgodAPI
+	blocks  types.BlockAPI
+	storage Storage
+	decoder *decode.Decoder
+
+	// pools are the last known states of pools by address
+	pools map[string]types.PoolInfo
+
+	ValidatorAppID uint64
+
+	// Snapshots stores a state of every pool touched or changed in a round. A state is derived from the previous state of the pool
+	// and the changes of the block, so it is known from the bootstrap of a pool, from its last stored snapshot,
+	// or from a state read while the node is still at the round. Snapshots of a store have to be taken in every indexed round to stay exact.
+	Snapshots bool
+
+	// OnRound is called after a round is stored
+	OnRound func(round *Round)
+}
+
+// New creates an indexer which reads blocks from an algod client, the algod client has to implement types.BlockAPI.
+// The excess amounts and pool states saved in the storage are restored, so indexing resumes as if it never stopped.
+func New(ac types.AlgodAPI, validatorAppID uint64, storage Storage) (*Indexer, error) {
+	blocks, ok := ac.(types.BlockAPI)
+	if !ok {
+		return nil, fmt.Errorf("algod client does not implement the block API")
+	}
+	if storage == nil {
+		return nil, fmt.Errorf("storage is required")
+	}
+
+	state, err := storage.State(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := decode.NewDecoder(validatorAppID)
+	for _, excess := range state.Excess {
+		if err := decoder.SetExcess(excess.UserAddress, excess.PoolAddress, excess.AssetID, excess.Amount); err != nil {
+			return nil, err
+		}
+	}
+	// restored amounts are already stored
+	decoder.ExcessChanges()
+
+	pools := make(map[string]types.PoolInfo)
+	for _, info := range state.Pools {
+		pools[info.Address] = info
+	}
+
+	return &Indexer{
+		ac:             ac,
+		blocks:         blocks,
+		storage:        storage,
+		decoder:        decoder,
+		pools:          pools,
+		ValidatorAppID: validatorAppID,
+	}, nil
+}
+
+// Sync indexes rounds from a given round to another inclusive and returns the last indexed round.
+// Rounds up to the last stored round are skipped, and a zero toRound means the latest round of the node, which has to implement types.StatusAPI.
+func (ix *Indexer) Sync(ctx context.Context, fromRound, toRound uint64) (uint64, error) {
+	lastRound, err := ix.storage.LastRound(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if toRound == 0 {
+		status, ok := ix.ac.(types.StatusAPI)
+		if !ok {
+			return 0, fmt.Errorf("algod client does not implement the status API")
+		}
+
+		nodeStatus, err := status.Status(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		toRound = nodeStatus.LastRound
+	}
+
+	round := fromRound
+	if lastRound >= round {
+		round = lastRound + 1
+	}
+	for ; round <= toRound; round++ {
+		if err := ctx.Err(); err != nil {
+			return round - 1, err
+		}
+		if err := ix.index(ctx, round); err != nil {
+			return round - 1, fmt.Errorf("failed to index round %d: %w", round, err)
+		}
+	}
+
+	if lastRound > toRound {
+		return lastRound, nil
+	}
+
+	return toRound, nil
+}
+
+// Follow catches up from a given round, then indexes every new block until the context is done.
+// The node has to implement types.StatusAPI.
+func (ix *Indexer) Follow(ctx context.Context, fromRound uint64) error {
+	status, ok := ix.ac.(types.StatusAPI)
+	if !ok {
+		return fmt.Errorf("algod client does not implement the status API")
+	}
+
+	round, err := ix.Sync(ctx, fromRound, 0)
+	if err != nil {
+		return err
+	}
+
+	for {
+		nodeStatus, err := status.StatusAfterBlock(ctx, round)
+		if err != nil {
+			return err
+		}
+		if nodeStatus.LastRound <= round {
+			continue
+		}
+
+		round, err = ix.Sync(ctx, fromRound, nodeStatus.LastRound)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// index decodes and stores a round
+func (ix *Indexer) index(ctx context.Context, round uint64) error {
+	block, err := ix.blocks.Block(ctx, round)
+	if err != nil {
+		return err
+	}
+
+	actions, err := ix.decoder.Block(block)
+	if err != nil {
+		return err
+	}
+
+	indexed := &Round{Round: round, Actions: actions, Excess: ix.decoder.ExcessChanges()}
+	var states map[string]types.PoolInfo
+	if ix.Snapshots {
+		indexed.Snapshots, states, err = ix.snapshots(ctx, block, actions)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := ix.storage.SaveRound(ctx, indexed); err != nil {
+		return err
+	}
+	for address, info := range states {
+		ix.pools[address] = info
+	}
+	if ix.OnRound != nil {
+		ix.OnRound(indexed)
+	}
+
+	return nil
+}
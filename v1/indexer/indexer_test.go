@@ -0,0 +1,353 @@
+package indexer_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/future"
+	algoTypes "github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/algodtest"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+	"github.com/synycboom/tinyman-go-sdk/v1/indexer"
+	"github.com/synycboom/tinyman-go-sdk/v1/pools"
+	"github.com/synycboom/tinyman-go-sdk/v1/prepare"
+	"github.com/synycboom/tinyman-go-sdk/v1/tinymantest"
+)
+
+const (
+	validatorAppID   = constants.TestnetValidatorAppId
+	usdcID           = uint64(10458941)
+	liquidityAssetID = uint64(62368708)
+)
+
+// setBlock stores a block of transaction groups
+func setBlock(node *algodtest.Node, round uint64, groups ...*utils.TransactionGroup) {
+	block := algoTypes.Block{}
+	block.Round = algoTypes.Round(round)
+	for _, txGroup := range groups {
+		for _, txn := range txGroup.Transactions() {
+			stib := algoTypes.SignedTxnInBlock{}
+			stib.Txn = txn
+			block.Payset = append(block.Payset, stib)
+		}
+	}
+
+	node.SetBlock(block)
+}
+
+func TestIndexer(t *testing.T) {
+	ctx := context.Background()
+	user := crypto.GenerateAccount().Address.String()
+	node := algodtest.NewNode()
+	node.SetAsset(usdcID, 6, "USDC", "USDC")
+	poolAddress, err := node.SetPool(types.PoolInfo{
+		Asset1ID:         usdcID,
+		Asset2ID:         0,
+		LiquidityAssetID: liquidityAssetID,
+		Asset1Reserves:   2000000000,
+		Asset2Reserves:   1000000000,
+		IssuedLiquidity:  1000000000,
+		ValidatorAppID:   validatorAppID,
+		AlgoBalance:      1000000000 + 100000 + 2*100000 + 100000 + 16*28500,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	sp, err := node.SuggestedParams(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	swap, err := prepare.SwapTransactions(validatorAppID, usdcID, 0, liquidityAssetID, 0, 1000000, 1990000, constants.SwapFixedInput, user, sp)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	mint, err := prepare.MintTransactions(validatorAppID, usdcID, 0, liquidityAssetID, 2000000, 1000000, 1400000, user, sp)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	burn, err := prepare.BurnTransactions(validatorAppID, usdcID, 0, liquidityAssetID, 2000000, 1000000, 1400000, user, sp)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	setBlock(node, 2, swap)
+	setBlock(node, 3, mint, burn)
+	node.SetRound(3)
+
+	path := filepath.Join(t.TempDir(), "index.db")
+	store, err := indexer.OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	ix, err := indexer.New(node, validatorAppID, store)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	ix.Snapshots = true
+
+	last, err := ix.Sync(ctx, 1, 0)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if last != 3 {
+		t.Fatalf("Sync should index up to round 3, got %d", last)
+	}
+
+	snapshots, err := store.Snapshots(ctx, poolAddress, 0, 10)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(snapshots) != 1 || snapshots[0].Round != 3 {
+		t.Fatalf("Only the snapshot of the current round should be stored, got %d", len(snapshots))
+	}
+	if err := store.Close(); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	// rounds are committed to the file, a reopened store resumes after them
+	store, err = indexer.OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	defer store.Close()
+
+	if last, err := store.LastRound(ctx); err != nil || last != 3 {
+		t.Fatalf("The reopened store should resume after round 3, got %d", last)
+	}
+
+	redeem, err := prepare.RedeemTransactions(validatorAppID, usdcID, 0, liquidityAssetID, usdcID, 17, user, sp)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	setBlock(node, 4, redeem)
+	node.SetRound(4)
+
+	ix, err = indexer.New(node, validatorAppID, store)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	ix.Snapshots = true
+
+	var indexed []uint64
+	ix.OnRound = func(round *indexer.Round) {
+		indexed = append(indexed, round.Round)
+	}
+	if _, err := ix.Sync(ctx, 1, 0); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(indexed) != 1 || indexed[0] != 4 {
+		t.Fatalf("Sync should only index round 4 after resuming, got %v", indexed)
+	}
+
+	snapshots, err = store.Snapshots(ctx, poolAddress, 0, 10)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(snapshots) != 2 || snapshots[1].Round != 4 || snapshots[1].Asset1Reserves != snapshots[0].Asset1Reserves {
+		t.Fatalf("A resumed indexer should continue from the stored snapshot, got %v", snapshots)
+	}
+
+	actions, err := store.Actions(ctx, nil)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(actions) != 4 {
+		t.Fatalf("Expected 4 stored actions, got %d", len(actions))
+	}
+
+	swaps, err := store.Actions(ctx, &indexer.Filter{PoolAddress: poolAddress, Types: []string{constants.ActionSwap}})
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(swaps) != 1 {
+		t.Fatalf("Expected 1 stored swap, got %d", len(swaps))
+	}
+	if event, ok := swaps[0].(*types.SwapEvent); !ok || event.Round != 2 || event.AmountIn != 1000000 {
+		t.Errorf("A stored swap should decode into a swap event, got %v", swaps[0])
+	}
+
+	inRound, err := store.Actions(ctx, &indexer.Filter{PoolAddress: poolAddress, FromRound: 3, ToRound: 3})
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(inRound) != 2 || inRound[0].Event().Type != constants.ActionMint || inRound[1].Event().Type != constants.ActionBurn {
+		t.Fatalf("Expected the mint and the burn of round 3, got %d actions", len(inRound))
+	}
+
+	otherPool, err := store.Actions(ctx, &indexer.Filter{PoolAddress: user})
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(otherPool) != 0 {
+		t.Fatalf("Expected no actions of another pool, got %d", len(otherPool))
+	}
+}
+
+func TestDerivedSnapshots(t *testing.T) {
+	e := tinymantest.New(t)
+	e.OptIn()
+	pool := e.CreatePool(e.CreateAsset("AAA"), e.CreateAsset("BBB"), 50000000, 20000000)
+	quote, err := pool.FetchFixedInputSwapQuote(e.Ctx, &types.AssetAmount{Asset: pool.Asset1, Amount: 1000000}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	e.Submit(pool.PrepareSwapTransactionsFromQuote(e.Ctx, quote, ""))
+
+	// indexing after the fact, every state is derived from the bootstrap of the pool
+	store := indexer.NewMemoryStore()
+	ix, err := indexer.New(e.Sim, e.Sim.ValidatorAppID(), store)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	ix.Snapshots = true
+	if _, err := ix.Sync(e.Ctx, 1, 0); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	poolAddress, err := pool.Address()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	snapshots, err := store.Snapshots(e.Ctx, poolAddress, 0, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(snapshots) != 3 {
+		t.Fatalf("Expected snapshots of the bootstrap, the mint and the swap, got %d", len(snapshots))
+	}
+
+	info, err := pools.PoolInfo(e.Ctx, e.Sim, e.Sim.ValidatorAppID(), pool.Asset1.ID, pool.Asset2.ID)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	derived := snapshots[len(snapshots)-1]
+	derived.Round = info.Round
+	if derived != *info {
+		t.Errorf("Expect derived state %+v but got %+v", *info, derived)
+	}
+}
+
+func TestResumeWithExcess(t *testing.T) {
+	e := tinymantest.New(t)
+	e.OptIn()
+	algo := e.Asset(0)
+	pool := e.CreatePool(e.CreateAsset("AAA"), algo, 50000000, 20000000)
+	poolAddress, err := pool.Address()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	// a fixed output swap leaves the unused input as excess, the next one adds to it
+	swap := func() {
+		quote, err := pool.FetchFixedOutputSwapQuote(e.Ctx, &types.AssetAmount{Asset: algo, Amount: 100000}, 100)
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+		e.Submit(pool.PrepareSwapTransactionsFromQuote(e.Ctx, quote, ""))
+	}
+	lastRound := func() uint64 {
+		status, err := e.Sim.Status(e.Ctx)
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		return status.LastRound
+	}
+
+	swap()
+
+	// a payment changes the pool without a Tinyman action
+	sp, err := e.Sim.SuggestedParams(e.Ctx)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	payment, err := future.MakePaymentTxn(e.Address(), poolAddress, 1000000, nil, "", sp)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	e.Submit(utils.NewTransactionGroup([]algoTypes.Transaction{payment}))
+	swap()
+
+	uninterrupted := indexer.NewMemoryStore()
+	ix, err := indexer.New(e.Sim, e.Sim.ValidatorAppID(), uninterrupted)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	ix.Snapshots = true
+	if _, err := ix.Sync(e.Ctx, 1, 0); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	expectedSwaps, err := uninterrupted.Actions(e.Ctx, &indexer.Filter{Types: []string{constants.ActionSwap}})
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(expectedSwaps) != 2 {
+		t.Fatalf("Expected 2 swaps, got %d", len(expectedSwaps))
+	}
+
+	// the first run stops after the round of the first swap, before the payment
+	stopRound := expectedSwaps[0].Event().Round
+	path := filepath.Join(t.TempDir(), "index.db")
+	for _, toRound := range []uint64{stopRound, 0} {
+		store, err := indexer.OpenFileStore(path)
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+		ix, err := indexer.New(e.Sim, e.Sim.ValidatorAppID(), store)
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+		ix.Snapshots = true
+		if _, err := ix.Sync(e.Ctx, 1, toRound); err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+		if err := store.Close(); err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+	}
+
+	resumed, err := indexer.OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	defer resumed.Close()
+
+	swaps, err := resumed.Actions(e.Ctx, &indexer.Filter{Types: []string{constants.ActionSwap}})
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(swaps) != 2 {
+		t.Fatalf("Expected 2 resumed swaps, got %d", len(swaps))
+	}
+	for idx := range swaps {
+		expected, actual := expectedSwaps[idx].(*types.SwapEvent), swaps[idx].(*types.SwapEvent)
+		if actual.AmountIn != expected.AmountIn || actual.Excess != expected.Excess || actual.Excess == 0 {
+			t.Errorf("A resumed swap should decode as %d in with %d excess, got %d in with %d excess",
+				expected.AmountIn, expected.Excess, actual.AmountIn, actual.Excess)
+		}
+	}
+
+	expectedSnapshots, err := uninterrupted.Snapshots(e.Ctx, poolAddress, 0, lastRound())
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	snapshots, err := resumed.Snapshots(e.Ctx, poolAddress, 0, lastRound())
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(snapshots) != len(expectedSnapshots) {
+		t.Fatalf("Expected %d snapshots, got %d", len(expectedSnapshots), len(snapshots))
+	}
+	for idx := range snapshots {
+		if snapshots[idx] != expectedSnapshots[idx] {
+			t.Errorf("Expect resumed snapshot %+v but got %+v", expectedSnapshots[idx], snapshots[idx])
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/v1/decode"
+)
+
+// MemoryStore is a Storage which keeps every round in memory
+type MemoryStore struct {
+	mu        sync.RWMutex
+	lastRound uint64
+	actions   []types.Action
+	snapshots map[string][]types.PoolInfo
+
+	// excess are excess amounts keyed by user, pool and asset
+	excess map[string]decode.ExcessAmount
+}
+
+var _ Storage = (*MemoryStore)(nil)
+
+// NewMemoryStore creates an empty in-memory storage
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		snapshots: make(map[string][]types.PoolInfo),
+		excess:    make(map[string]decode.ExcessAmount),
+	}
+}
+
+// SaveRound stores actions and pool snapshots of a round and marks the round as processed
+func (s *MemoryStore) SaveRound(ctx context.Context, round *Round) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.save(round)
+}
+
+// LastRound returns the last processed round
+func (s *MemoryStore) LastRound(ctx context.Context) (uint64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.lastRound, nil
+}
+
+// Actions returns stored actions matching a filter in round order
+func (s *MemoryStore) Actions(ctx context.Context, filter *Filter) ([]types.Action, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var actions []types.Action
+	for _, action := range s.actions {
+		if filter.match(action) {
+			actions = append(actions, action)
+		}
+	}
+
+	return actions, nil
+}
+
+// Snapshots returns stored snapshots of a pool read between two rounds inclusive in round order
+func (s *MemoryStore) Snapshots(ctx context.Context, poolAddress string, fromRound, toRound uint64) ([]types.PoolInfo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var snapshots []types.PoolInfo
+	for _, snapshot := range s.snapshots[poolAddress] {
+		if snapshot.Round >= fromRound && snapshot.Round <= toRound {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+
+	return snapshots, nil
+}
+
+// State returns the excess amounts and the last snapshot of every pool
+func (s *MemoryStore) State(ctx context.Context) (*State, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	state := &State{}
+	for _, excess := range s.excess {
+		state.Excess = append(state.Excess, excess)
+	}
+	for _, snapshots := range s.snapshots {
+		state.Pools = append(state.Pools, snapshots[len(snapshots)-1])
+	}
+
+	return state, nil
+}
+
+// save stores a round, the lock has to be held
+func (s *MemoryStore) save(round *Round) error {
+	if round.Round <= s.lastRound {
+		return fmt.Errorf("round %d is not after the last processed round %d", round.Round, s.lastRound)
+	}
+
+	s.actions = append(s.actions, round.Actions...)
+	for _, snapshot := range round.Snapshots {
+		s.snapshots[snapshot.Address] = append(s.snapshots[snapshot.Address], snapshot)
+	}
+	for _, excess := range round.Excess {
+		key := fmt.Sprintf("%s/%s/%d", excess.UserAddress, excess.PoolAddress, excess.AssetID)
+		if excess.Amount == 0 {
+			delete(s.excess, key)
+
+			continue
+		}
+
+		s.excess[key] = excess
+	}
+	s.lastRound = round.Round
+
+	return nil
+}
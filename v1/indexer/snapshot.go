@@ -0,0 +1,214 @@
+package indexer
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+
+	algoTypes "github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+	"github.com/synycboom/tinyman-go-sdk/v1/pools"
+)
+
+// snapshots returns states of pools touched or changed in a block and the states to keep for the next round.
+// A known pool state is advanced by the validator app local state changes and the Algo transfers of the block,
+// a pool without a known state starts empty at its bootstrap, from its last stored snapshot, or from a state read while the node is still at the round.
+func (ix *Indexer) snapshots(ctx context.Context, block algoTypes.Block, actions []types.Action) ([]types.PoolInfo, map[string]types.PoolInfo, error) {
+	round := uint64(block.Round)
+	states := make(map[string]types.PoolInfo)
+	state := func(address string) (types.PoolInfo, bool) {
+		if info, ok := states[address]; ok {
+			return info, true
+		}
+		info, ok := ix.pools[address]
+
+		return info, ok
+	}
+
+	var touched []string
+	seen := make(map[string]bool)
+	read := make(map[string]types.PoolInfo)
+	for _, action := range actions {
+		event := action.Event()
+		if seen[event.PoolAddress] {
+			continue
+		}
+		seen[event.PoolAddress] = true
+		touched = append(touched, event.PoolAddress)
+		if _, ok := state(event.PoolAddress); ok {
+			continue
+		}
+
+		if bootstrap, ok := action.(*types.BootstrapEvent); ok {
+			states[event.PoolAddress] = types.PoolInfo{
+				Address:            event.PoolAddress,
+				Asset1ID:           event.Asset1ID,
+				Asset2ID:           event.Asset2ID,
+				LiquidityAssetID:   bootstrap.LiquidityAssetID,
+				LiquidityAssetName: createdAssetName(block, bootstrap.LiquidityAssetID),
+				ValidatorAppID:     event.ValidatorAppID,
+			}
+
+			continue
+		}
+
+		stored, err := ix.storage.Snapshots(ctx, event.PoolAddress, 0, round-1)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(stored) > 0 {
+			states[event.PoolAddress] = stored[len(stored)-1]
+
+			continue
+		}
+
+		// a state read at the round already includes the changes of the block, it is kept aside until the block is applied
+		if event.Type == constants.ActionUnknown {
+			continue
+		}
+		info, err := pools.PoolInfo(ctx, ix.ac, event.ValidatorAppID, event.Asset1ID, event.Asset2ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if info != nil && info.Round == round {
+			read[event.PoolAddress] = *info
+		}
+	}
+
+	changed := make(map[string]bool)
+	change := func(addr algoTypes.Address, apply func(info *types.PoolInfo)) {
+		address := addr.String()
+		info, ok := state(address)
+		if !ok {
+			return
+		}
+
+		apply(&info)
+		states[address] = info
+		changed[address] = true
+	}
+	for _, stib := range block.Payset {
+		applyTransaction(stib, ix.ValidatorAppID, change)
+	}
+	for address, info := range read {
+		states[address] = info
+	}
+
+	var addresses []string
+	for address := range changed {
+		if !seen[address] {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+
+	var snapshots []types.PoolInfo
+	for _, address := range append(touched, addresses...) {
+		info, ok := states[address]
+		if !ok {
+			continue
+		}
+
+		info.Round = round
+		states[address] = info
+		snapshots = append(snapshots, info)
+	}
+
+	return snapshots, states, nil
+}
+
+// applyTransaction applies Algo movements and validator app local state changes of a transaction to pool states
+func applyTransaction(stib algoTypes.SignedTxnInBlock, validatorAppID uint64, change func(addr algoTypes.Address, apply func(info *types.PoolInfo))) {
+	txn := stib.Txn
+	change(txn.Sender, func(info *types.PoolInfo) {
+		info.AlgoBalance = info.AlgoBalance + uint64(stib.SenderRewards) - uint64(txn.Fee)
+		if txn.Type == algoTypes.PaymentTx {
+			info.AlgoBalance -= uint64(txn.Amount) + uint64(stib.ClosingAmount)
+		}
+	})
+	if txn.Type == algoTypes.PaymentTx {
+		change(txn.Receiver, func(info *types.PoolInfo) {
+			info.AlgoBalance += uint64(txn.Amount) + uint64(stib.ReceiverRewards)
+		})
+		if !txn.CloseRemainderTo.IsZero() {
+			change(txn.CloseRemainderTo, func(info *types.PoolInfo) {
+				info.AlgoBalance += uint64(stib.ClosingAmount) + uint64(stib.CloseRewards)
+			})
+		}
+	}
+
+	if txn.Type != algoTypes.ApplicationCallTx || uint64(txn.ApplicationID) != validatorAppID {
+		return
+	}
+
+	accounts := append([]algoTypes.Address{txn.Sender}, txn.Accounts...)
+	for offset, delta := range stib.EvalDelta.LocalDeltas {
+		if offset >= uint64(len(accounts)) {
+			continue
+		}
+
+		delta := delta
+		change(accounts[offset], func(info *types.PoolInfo) {
+			applyStateDelta(info, delta)
+		})
+	}
+}
+
+// applyStateDelta applies changes of a pool local state, asset ids are applied before outstanding amounts which are keyed by them
+func applyStateDelta(info *types.PoolInfo, delta algoTypes.StateDelta) {
+	value := func(key string) (uint64, bool) {
+		v, ok := delta[key]
+		if !ok {
+			return 0, false
+		}
+		if v.Action == algoTypes.SetUintAction {
+			return v.Uint, true
+		}
+
+		return 0, true
+	}
+
+	fields := map[string]*uint64{
+		"a1":  &info.Asset1ID,
+		"a2":  &info.Asset2ID,
+		"s1":  &info.Asset1Reserves,
+		"s2":  &info.Asset2Reserves,
+		"ilt": &info.IssuedLiquidity,
+		"p":   &info.UnclaimedProtocolFee,
+	}
+	for key, field := range fields {
+		if v, ok := value(key); ok {
+			*field = v
+		}
+	}
+
+	// an outstanding amount key is 'o' followed by an asset id
+	for key := range delta {
+		if len(key) != 9 || key[0] != 'o' {
+			continue
+		}
+
+		v, _ := value(key)
+		switch binary.BigEndian.Uint64([]byte(key[1:])) {
+		case info.Asset1ID:
+			info.OutstandingAsset1Amount = v
+		case info.Asset2ID:
+			info.OutstandingAsset2Amount = v
+		case info.LiquidityAssetID:
+			info.OutstandingLiquidityAssetAmount = v
+		}
+	}
+}
+
+// createdAssetName returns the name of an asset created in a block
+func createdAssetName(block algoTypes.Block, assetID uint64) string {
+	for _, stib := range block.Payset {
+		if stib.ConfigAsset == assetID {
+			return stib.Txn.AssetParams.AssetName
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,138 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+	"github.com/synycboom/tinyman-go-sdk/v1/decode"
+)
+
+// Storage stores indexed rounds, an implementation has to be safe for concurrent use
+type Storage interface {
+	// SaveRound stores actions and pool snapshots of a round and marks the round as processed in one step,
+	// rounds are saved in increasing order
+	SaveRound(ctx context.Context, round *Round) error
+
+	// LastRound returns the last processed round, zero when no round was processed
+	LastRound(ctx context.Context) (uint64, error)
+
+	// Actions returns stored actions matching a filter in round order
+	Actions(ctx context.Context, filter *Filter) ([]types.Action, error)
+
+	// Snapshots returns stored snapshots of a pool read between two rounds inclusive in round order
+	Snapshots(ctx context.Context, poolAddress string, fromRound, toRound uint64) ([]types.PoolInfo, error)
+
+	// State returns the excess amounts and the last snapshot of every pool saved up to the last processed round
+	State(ctx context.Context) (*State, error)
+}
+
+// Round is the indexed data of a round
+type Round struct {
+	// Round is the round number
+	Round uint64
+
+	// Actions are the decoded Tinyman groups of the round in block order
+	Actions []types.Action
+
+	// Snapshots are states of pools touched or changed in the round, a pool whose earlier state is unknown has none
+	Snapshots []types.PoolInfo
+
+	// Excess are excess amounts changed in the round, a zero amount removes a stored one
+	Excess []decode.ExcessAmount
+}
+
+// State is what an indexer carries from a round to the next one, it is restored when an indexer resumes
+type State struct {
+	// Excess are non-zero excess amounts of users in pools
+	Excess []decode.ExcessAmount
+
+	// Pools are the last snapshots of pools
+	Pools []types.PoolInfo
+}
+
+// Filter selects stored actions
+type Filter struct {
+	// PoolAddress keeps actions of a pool, empty means every pool
+	PoolAddress string
+
+	// Types keeps actions of the given constants.Action types, empty means every type
+	Types []string
+
+	// FromRound and ToRound keep actions between two rounds inclusive, a zero ToRound means no upper bound
+	FromRound uint64
+	ToRound   uint64
+}
+
+// match checks whether an action matches the filter
+func (f *Filter) match(action types.Action) bool {
+	if f == nil {
+		return true
+	}
+
+	event := action.Event()
+	if len(f.PoolAddress) > 0 && event.PoolAddress != f.PoolAddress {
+		return false
+	}
+	if event.Round < f.FromRound || (f.ToRound > 0 && event.Round > f.ToRound) {
+		return false
+	}
+	if len(f.Types) == 0 {
+		return true
+	}
+
+	for _, actionType := range f.Types {
+		if event.Type == actionType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// actionRecord is an action encoded with its type so that it can be decoded into the right event
+type actionRecord struct {
+	Type  string          `json:"type"`
+	Event json.RawMessage `json:"event"`
+}
+
+// encodeAction encodes an action as a JSON record
+func encodeAction(action types.Action) (actionRecord, error) {
+	data, err := json.Marshal(action)
+	if err != nil {
+		return actionRecord{}, err
+	}
+
+	return actionRecord{Type: action.Event().Type, Event: data}, nil
+}
+
+// decodeAction decodes a JSON record into an event of its type
+func decodeAction(record actionRecord) (types.Action, error) {
+	var action types.Action
+	switch record.Type {
+	case constants.ActionBootstrap:
+		action = &types.BootstrapEvent{}
+	case constants.ActionSwap:
+		action = &types.SwapEvent{}
+	case constants.ActionMint:
+		action = &types.MintEvent{}
+	case constants.ActionBurn:
+		action = &types.BurnEvent{}
+	case constants.ActionRedeem:
+		action = &types.RedeemEvent{}
+	case constants.ActionFees:
+		action = &types.FeesEvent{}
+	case constants.ActionUnknown:
+		action = &types.UnknownEvent{}
+	default:
+		return nil, fmt.Errorf("unknown action type '%s'", record.Type)
+	}
+
+	if err := json.Unmarshal(record.Event, action); err != nil {
+		return nil, err
+	}
+
+	return action, nil
+}
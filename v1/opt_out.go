@@ -0,0 +1,196 @@
+package tinyman
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	algoTypes "github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/contracts"
+	"github.com/synycboom/tinyman-go-sdk/v1/prepare"
+)
+
+// OptOutOptions configures opting a user out of the validator app
+type OptOutOptions struct {
+	// RedeemExcess redeems every outstanding excess amount before opting out instead of refusing to opt out
+	RedeemExcess bool
+
+	// CloseOut closes the holdings of liquidity assets of the validator app which the user holds none of, and the holdings of the assets
+	// of their pools which the user holds none of, to reclaim their minimum balance. Holdings are closed to the asset creators
+	// as they are read when preparing, and holdings receiving redeemed excess amounts are kept.
+	CloseOut bool
+}
+
+// PrepareAppOptOutTransaction prepares a plan which opts a user out of the validator app and reclaims the minimum balance of its local state.
+// An opt-out discards excess amounts stored in the local state, so it returns types.ErrExcessOutstanding when any of them is not redeemed yet
+// unless OptOutOptions.RedeemExcess is set, in which case the plan redeems them first.
+func (c *Client) PrepareAppOptOutTransaction(ctx context.Context, userAddress string, opts *OptOutOptions) (*utils.TransactionPlan, error) {
+	if len(userAddress) == 0 {
+		userAddress = c.UserAddress
+	}
+	if opts == nil {
+		opts = &OptOutOptions{}
+	}
+
+	var txGroups []*utils.TransactionGroup
+	redeemed := make(map[uint64]bool)
+	if opts.RedeemExcess {
+		sweep, err := c.PrepareExcessSweep(ctx, userAddress, &SweepOptions{RedeemAll: true})
+		if err != nil {
+			return nil, err
+		}
+
+		txGroups = append(txGroups, sweep.Plan.Groups()...)
+		for _, quote := range sweep.Redeemed {
+			redeemed[quote.Amount.Asset.ID] = true
+		}
+	} else {
+		quotes, err := c.FetchExcessAmount(ctx, userAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		outstanding := 0
+		for _, quote := range quotes {
+			if quote.Amount.Amount > 0 {
+				outstanding++
+			}
+		}
+		if outstanding > 0 {
+			return nil, fmt.Errorf("%d excess amounts would be lost: %w", outstanding, types.ErrExcessOutstanding)
+		}
+	}
+
+	sp, err := c.ac.SuggestedParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txGroup, err := prepare.AppOptOutTransactions(c.ValidatorAppID, userAddress, sp)
+	if err != nil {
+		return nil, err
+	}
+	txGroups = append(txGroups, txGroup)
+
+	if opts.CloseOut {
+		assetIDs, closeToAddresses, err := c.closableHoldings(ctx, userAddress, redeemed)
+		if err != nil {
+			return nil, err
+		}
+
+		for start := 0; start < len(assetIDs); start += algoTypes.MaxTxGroupSize {
+			end := start + algoTypes.MaxTxGroupSize
+			if end > len(assetIDs) {
+				end = len(assetIDs)
+			}
+
+			closeGroup, err := prepare.AssetCloseTransactions(assetIDs[start:end], closeToAddresses[start:end], userAddress, sp)
+			if err != nil {
+				return nil, err
+			}
+
+			txGroups = append(txGroups, closeGroup)
+		}
+	}
+
+	return utils.NewTransactionPlan(txGroups...), nil
+}
+
+// closableHoldings returns zero balance holdings of a user of liquidity assets of the validator app and of the assets of their pools
+// with the creators to close them to, holdings of kept assets are skipped
+func (c *Client) closableHoldings(ctx context.Context, userAddress string, kept map[uint64]bool) ([]uint64, []string, error) {
+	account, err := c.ac.AccountInformation(ctx, userAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	empty := make(map[uint64]bool)
+	for _, holding := range account.Assets {
+		if holding.Amount == 0 && !kept[holding.AssetId] {
+			empty[holding.AssetId] = true
+		}
+	}
+
+	var assetIDs []uint64
+	var closeToAddresses []string
+	closing := make(map[uint64]bool)
+	for _, holding := range account.Assets {
+		if !empty[holding.AssetId] || closing[holding.AssetId] {
+			continue
+		}
+
+		liquidityAsset, err := c.ac.GetAssetByID(ctx, holding.AssetId)
+		if err != nil {
+			return nil, nil, err
+		}
+		if liquidityAsset.Params.UnitName != contracts.LiquidityAssetUnitName(c.ValidatorAppID) {
+			continue
+		}
+
+		asset1ID, asset2ID, ok, err := c.poolAssets(ctx, liquidityAsset.Params.Creator)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		assetIDs = append(assetIDs, holding.AssetId)
+		closeToAddresses = append(closeToAddresses, liquidityAsset.Params.Creator)
+		closing[holding.AssetId] = true
+		for _, assetID := range []uint64{asset1ID, asset2ID} {
+			if assetID == 0 || !empty[assetID] || closing[assetID] {
+				continue
+			}
+
+			asset, err := c.ac.GetAssetByID(ctx, assetID)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			assetIDs = append(assetIDs, assetID)
+			closeToAddresses = append(closeToAddresses, asset.Params.Creator)
+			closing[assetID] = true
+		}
+	}
+
+	return assetIDs, closeToAddresses, nil
+}
+
+// poolAssets returns asset ids of a pool of the validator app at an address, ok is false when the address is not such a pool
+func (c *Client) poolAssets(ctx context.Context, poolAddress string) (uint64, uint64, bool, error) {
+	account, err := c.ac.AccountInformation(ctx, poolAddress)
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	for _, ls := range account.AppsLocalState {
+		if ls.Id != c.ValidatorAppID {
+			continue
+		}
+
+		validatorAppState := make(map[string]models.TealValue)
+		for _, kv := range ls.KeyValue {
+			validatorAppState[kv.Key] = kv.Value
+		}
+
+		asset1ID := utils.StateInt(validatorAppState, "a1")
+		asset2ID := utils.StateInt(validatorAppState, "a2")
+		poolAccount, err := contracts.PoolLogicSigAccount(c.ValidatorAppID, asset1ID, asset2ID)
+		if err != nil {
+			return 0, 0, false, err
+		}
+
+		address, err := poolAccount.Address()
+		if err != nil {
+			return 0, 0, false, err
+		}
+
+		return asset1ID, asset2ID, address.String() == poolAddress, nil
+	}
+
+	return 0, 0, false, nil
+}
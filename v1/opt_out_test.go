@@ -0,0 +1,143 @@
+package tinyman_test
+
+import (
+	"errors"
+	"testing"
+
+	algoTypes "github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1"
+	"github.com/synycboom/tinyman-go-sdk/v1/tinymantest"
+)
+
+func TestPrepareAppOptOutTransaction(t *testing.T) {
+	e := tinymantest.New(t)
+	e.OptIn()
+	algo, err := e.Client.FetchAsset(e.Ctx, 0)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	token := e.CreateAsset("TKN")
+	pool := e.CreatePool(token, algo, 100000000000, 10000000000)
+
+	quote, err := pool.FetchFixedInputSwapQuote(e.Ctx, &types.AssetAmount{Asset: token, Amount: 100000}, 500)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	e.Submit(pool.PrepareSwapTransactionsFromQuote(e.Ctx, quote, ""))
+
+	if _, err := e.Client.PrepareAppOptOutTransaction(e.Ctx, "", nil); !errors.Is(err, types.ErrExcessOutstanding) {
+		t.Fatalf("PrepareAppOptOutTransaction should refuse to discard the excess, got %v", err)
+	}
+
+	// the excess is worth less than the redeem fee but is redeemed anyway
+	plan, err := e.Client.PrepareAppOptOutTransaction(e.Ctx, "", &tinyman.OptOutOptions{RedeemExcess: true})
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(plan.Groups()) != 2 {
+		t.Fatalf("The plan should redeem the excess before opting out, got %d groups", len(plan.Groups()))
+	}
+
+	excess, err := e.Client.FetchExcessAmount(e.Ctx, "")
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(excess) != 1 || excess[0].Amount.Asset.ID != 0 {
+		t.Fatalf("User should have an ALGO excess, got %d excess amounts", len(excess))
+	}
+
+	var cost uint64
+	for _, txGroup := range plan.Groups() {
+		for _, tx := range txGroup.Transactions() {
+			if tx.Sender == e.User.Address {
+				cost += uint64(tx.Fee) + uint64(tx.Amount)
+			}
+		}
+	}
+
+	before := e.Sim.Balance(e.User.Address.String(), 0)
+	if err := plan.SignWith(e.Ctx, utils.NewAccountSigner(e.User)); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if _, err := plan.Submit(e.Ctx, e.Sim, true); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if after := e.Sim.Balance(e.User.Address.String(), 0); after+cost != before+excess[0].Amount.Amount {
+		t.Errorf("User should receive the excess of %d before opting out, balance went from %d to %d", excess[0].Amount.Amount, before, after)
+	}
+
+	optedIn, err := e.Client.IsOptedIn(e.Ctx, "")
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if optedIn {
+		t.Errorf("User should be opted out")
+	}
+}
+
+func TestPrepareAppOptOutTransactionCloseOut(t *testing.T) {
+	e := tinymantest.New(t)
+	e.OptIn()
+	algo := e.Asset(0)
+	token, other := e.CreateAsset("TKN"), e.CreateAsset("OTH")
+	pool := e.CreatePool(token, algo, 100000000000, 10000000000)
+	poolAddress, err := pool.Address()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	// a user who left the pool still holds empty holdings of the liquidity asset and the token
+	lp := e.NewUser()
+	lp.OptIn()
+	for assetID, amount := range map[uint64]uint64{pool.LiquidityAsset.ID: 0, token.ID: 0, other.ID: 0} {
+		if err := e.Sim.SetBalance(lp.Address(), assetID, amount); err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+	}
+
+	plan, err := lp.Client.PrepareAppOptOutTransaction(lp.Ctx, "", &tinyman.OptOutOptions{CloseOut: true})
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	groups := plan.Groups()
+	if len(groups) != 2 {
+		t.Fatalf("The plan should opt out and close the holdings, got %d groups", len(groups))
+	}
+	if optOut := groups[0].Transactions(); len(optOut) != 1 || optOut[0].OnCompletion != algoTypes.ClearStateOC {
+		t.Fatalf("The plan should opt out with a clear state call")
+	}
+
+	closes := groups[1].Transactions()
+	expected := []struct {
+		assetID uint64
+		closeTo string
+	}{{pool.LiquidityAsset.ID, poolAddress}, {token.ID, e.Address()}}
+	if len(closes) != len(expected) {
+		t.Fatalf("The plan should close %d holdings, got %d", len(expected), len(closes))
+	}
+	for idx, tx := range closes {
+		if uint64(tx.XferAsset) != expected[idx].assetID || tx.AssetCloseTo.String() != expected[idx].closeTo || tx.AssetAmount != 0 {
+			t.Errorf("The plan should close asset %d to %s, got asset %d to %s", expected[idx].assetID, expected[idx].closeTo, tx.XferAsset, tx.AssetCloseTo)
+		}
+	}
+
+	if err := plan.SignWith(lp.Ctx, utils.NewAccountSigner(lp.User)); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if _, err := plan.Submit(lp.Ctx, e.Sim, true); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	for assetID, kept := range map[uint64]bool{pool.LiquidityAsset.ID: false, token.ID: false, other.ID: true} {
+		optedIn, err := lp.Client.IsAssetOptedIn(lp.Ctx, assetID, "")
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+		if optedIn != kept {
+			t.Errorf("Holding of asset %d should be kept %v", assetID, kept)
+		}
+	}
+}
@@ -13,7 +13,7 @@ func (p *Pool) PrepareBootstrapTransactions(ctx context.Context, bootstrapperAdd
 		bootstrapperAddress = p.UserAddress
 	}
 
-	sp, err := p.ac.SuggestedParams().Do(ctx)
+	sp, err := p.ac.SuggestedParams(ctx)
 	if err != nil {
 		return nil, err
 	}
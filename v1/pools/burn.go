@@ -26,7 +26,7 @@ func (p *Pool) PrepareBurnTransactions(
 		burnerAddress = p.UserAddress
 	}
 
-	sp, err := p.ac.SuggestedParams().Do(ctx)
+	sp, err := p.ac.SuggestedParams(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -67,3 +67,13 @@ func (p *Pool) PrepareBurnTransactionsFromQuote(ctx context.Context, quote *type
 		burnerAddress,
 	)
 }
+
+// PrepareBurnPlanFromQuote prepares burn transactions from a given burn quote, together with the app and asset opt-ins the user is missing
+func (p *Pool) PrepareBurnPlanFromQuote(ctx context.Context, quote *types.BurnQuote, burnerAddress string) (*utils.TransactionPlan, error) {
+	txGroup, err := p.PrepareBurnTransactionsFromQuote(ctx, quote, burnerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.PrepareOptInPlan(ctx, txGroup, burnerAddress)
+}
@@ -5,54 +5,54 @@ import (
 	"fmt"
 
 	"github.com/synycboom/tinyman-go-sdk/types"
-	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/quote"
 )
 
-// FetchBurnQuote returns a burn quote
-func (p *Pool) FetchBurnQuote(ctx context.Context, liquidityAsset *types.AssetAmount, slippage float64) (*types.BurnQuote, error) {
+// FetchBurnQuote refreshes the pool and returns a burn quote, a pool using a cache is only refreshed when its cached state is stale
+func (p *Pool) FetchBurnQuote(ctx context.Context, liquidityAsset *types.AssetAmount, slippageBps uint64) (*types.BurnQuote, error) {
 	if liquidityAsset == nil {
 		return nil, fmt.Errorf("liquidityAsset is required")
 	}
 
-	if slippage == 0 {
-		slippage = 0.05
+	if err := p.RefreshIfStale(ctx); err != nil {
+		return nil, err
+	}
+
+	return p.BurnQuote(liquidityAsset, slippageBps)
+}
+
+// BurnQuote returns a burn quote from the current pool state without refreshing it
+func (p *Pool) BurnQuote(liquidityAsset *types.AssetAmount, slippageBps uint64) (*types.BurnQuote, error) {
+	if liquidityAsset == nil {
+		return nil, fmt.Errorf("liquidityAsset is required")
 	}
 
 	if !liquidityAsset.Asset.Equal(p.LiquidityAsset) {
-		return nil, fmt.Errorf("the liquidity asset is not the same as one in a pool")
+		return nil, fmt.Errorf("the liquidity asset is not the same as one in a pool: %w", types.ErrAssetMismatch)
 	}
 
-	if err := p.Refresh(ctx, nil); err != nil {
+	asset1Amount, asset2Amount, err := quote.BurnAmounts(p.Asset1Reserves, p.Asset2Reserves, p.IssuedLiquidity, liquidityAsset.Amount)
+	if err != nil {
 		return nil, err
 	}
 
-	asset1Amount := utils.BigIntDiv(
-		utils.BigIntMul(
-			utils.ToBigUint(liquidityAsset.Amount),
-			utils.ToBigUint(p.Asset1Reserves),
-		),
-		utils.ToBigUint(p.IssuedLiquidity),
-	)
-	asset2Amount := utils.BigIntDiv(
-		utils.BigIntMul(
-			utils.ToBigUint(liquidityAsset.Amount),
-			utils.ToBigUint(p.Asset2Reserves),
-		),
-		utils.ToBigUint(p.IssuedLiquidity),
-	)
-
-	return &types.BurnQuote{
+	burnQuote := &types.BurnQuote{
 		AmountsOut: map[uint64]types.AssetAmount{
 			p.Asset1.ID: {
 				Asset:  p.Asset1,
-				Amount: asset1Amount.Uint64(),
+				Amount: asset1Amount,
 			},
 			p.Asset2.ID: {
 				Asset:  p.Asset2,
-				Amount: asset2Amount.Uint64(),
+				Amount: asset2Amount,
 			},
 		},
 		LiquidityAssetAmount: *liquidityAsset,
-		Slippage:             slippage,
-	}, nil
+		SlippageBps:          slippageBps,
+	}
+	if err := burnQuote.SetBounds(); err != nil {
+		return nil, err
+	}
+
+	return burnQuote, nil
 }
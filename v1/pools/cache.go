@@ -0,0 +1,126 @@
+package pools
+
+import (
+	"sync"
+	"time"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+)
+
+// StateCache is a pool state cache keyed by pool address which can be shared by pools and goroutines.
+// The cache tracks the latest round it has seen, a state fetched more than MaxAgeRounds rounds before it is stale.
+// Pool.RefreshIfStale advances the cache to the current round of the node, Get and Pool.FromCache rely on the rounds seen so far.
+type StateCache struct {
+	mu          sync.RWMutex
+	entries     map[string]stateCacheEntry
+	latestRound uint64
+
+	// MaxAgeRounds is the number of rounds a state stays fresh after the round it was read at, zero means the same round only
+	MaxAgeRounds uint64
+
+	// MaxAge is the time a state stays fresh after it was stored, zero means no time limit
+	MaxAge time.Duration
+}
+
+type stateCacheEntry struct {
+	info     types.PoolInfo
+	storedAt time.Time
+}
+
+// NewStateCache creates a pool state cache with a freshness policy
+func NewStateCache(maxAgeRounds uint64, maxAge time.Duration) *StateCache {
+	return &StateCache{
+		entries:      make(map[string]stateCacheEntry),
+		MaxAgeRounds: maxAgeRounds,
+		MaxAge:       maxAge,
+	}
+}
+
+// Get returns a copy of the state of a pool address, it is false when the state is missing or stale
+func (c *StateCache) Get(address string) (*types.PoolInfo, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[address]
+	if !ok || !c.fresh(entry) {
+		return nil, false
+	}
+
+	info := entry.info
+
+	return &info, true
+}
+
+// Put stores a pool state unless the cache holds a state of the same pool read at a later round
+func (c *StateCache) Put(info *types.PoolInfo) {
+	if info == nil || len(info.Address) == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry, ok := c.entries[info.Address]; ok && entry.info.Round > info.Round {
+		return
+	}
+
+	c.entries[info.Address] = stateCacheEntry{
+		info:     *info,
+		storedAt: time.Now(),
+	}
+	if info.Round > c.latestRound {
+		c.latestRound = info.Round
+	}
+}
+
+// Advance tells the cache that the chain has reached a round, states older than the freshness policy become stale
+func (c *StateCache) Advance(round uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if round > c.latestRound {
+		c.latestRound = round
+	}
+}
+
+// LatestRound returns the latest round the cache has seen
+func (c *StateCache) LatestRound() uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.latestRound
+}
+
+// Invalidate removes states of given pool addresses
+func (c *StateCache) Invalidate(addresses ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, address := range addresses {
+		delete(c.entries, address)
+	}
+}
+
+// InvalidateBefore removes states read before a given round
+func (c *StateCache) InvalidateBefore(round uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for address, entry := range c.entries {
+		if entry.info.Round < round {
+			delete(c.entries, address)
+		}
+	}
+}
+
+// fresh checks an entry against the freshness policy
+func (c *StateCache) fresh(entry stateCacheEntry) bool {
+	if entry.info.Round+c.MaxAgeRounds < c.latestRound {
+		return false
+	}
+	if c.MaxAge > 0 && time.Since(entry.storedAt) > c.MaxAge {
+		return false
+	}
+
+	return true
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/algorand/go-algorand-sdk/client/v2/algod"
 	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
 
 	"github.com/synycboom/tinyman-go-sdk/types"
@@ -13,7 +12,7 @@ import (
 )
 
 // PoolInfo returns pool information for the given asset1 and asset2
-func PoolInfo(ctx context.Context, ac *algod.Client, validatorAppID, asset1ID, asset2ID uint64) (*types.PoolInfo, error) {
+func PoolInfo(ctx context.Context, ac types.AlgodAPI, validatorAppID, asset1ID, asset2ID uint64) (*types.PoolInfo, error) {
 	poolAccount, err := contracts.PoolLogicSigAccount(validatorAppID, asset1ID, asset2ID)
 	if err != nil {
 		return nil, err
@@ -24,7 +23,7 @@ func PoolInfo(ctx context.Context, ac *algod.Client, validatorAppID, asset1ID, a
 		return nil, err
 	}
 
-	accountInfo, err := ac.AccountInformation(poolAddress.String()).Do(ctx)
+	accountInfo, err := ac.AccountInformation(ctx, poolAddress.String())
 	if err != nil {
 		return nil, err
 	}
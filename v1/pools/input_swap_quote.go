@@ -5,22 +5,27 @@ import (
 	"fmt"
 
 	"github.com/synycboom/tinyman-go-sdk/types"
-	"github.com/synycboom/tinyman-go-sdk/utils"
 	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+	"github.com/synycboom/tinyman-go-sdk/v1/quote"
 )
 
-// FetchFixedInputSwapQuote returns a fixed input swap quote
-func (p *Pool) FetchFixedInputSwapQuote(ctx context.Context, amountIn *types.AssetAmount, slippage float64) (*types.SwapQuote, error) {
-	if slippage == 0 {
-		slippage = 0.05
+// FetchFixedInputSwapQuote refreshes the pool and returns a fixed input swap quote, a pool using a cache is only refreshed when its cached state is stale
+func (p *Pool) FetchFixedInputSwapQuote(ctx context.Context, amountIn *types.AssetAmount, slippageBps uint64) (*types.SwapQuote, error) {
+	if err := p.RefreshIfStale(ctx); err != nil {
+		return nil, err
 	}
 
-	assetIn := amountIn.Asset
-	assetInAmount := amountIn.Amount
-	if err := p.Refresh(ctx, nil); err != nil {
-		return nil, err
+	return p.FixedInputSwapQuote(amountIn, slippageBps)
+}
+
+// FixedInputSwapQuote returns a fixed input swap quote from the current pool state without refreshing it
+func (p *Pool) FixedInputSwapQuote(amountIn *types.AssetAmount, slippageBps uint64) (*types.SwapQuote, error) {
+	if amountIn == nil {
+		return nil, fmt.Errorf("amountIn is required")
 	}
 
+	assetIn := amountIn.Asset
+	assetInAmount := amountIn.Amount
 	var assetOut *types.Asset
 	var inputSupply uint64
 	var outputSupply uint64
@@ -28,35 +33,25 @@ func (p *Pool) FetchFixedInputSwapQuote(ctx context.Context, amountIn *types.Ass
 		assetOut = p.Asset2
 		inputSupply = p.Asset1Reserves
 		outputSupply = p.Asset2Reserves
-	} else {
+	} else if assetIn.Equal(p.Asset2) {
 		assetOut = p.Asset1
 		inputSupply = p.Asset2Reserves
 		outputSupply = p.Asset1Reserves
+	} else {
+		return nil, types.ErrAssetMismatch
 	}
 
-	if inputSupply == 0 || outputSupply == 0 {
-		return nil, fmt.Errorf("pool has no liquidity")
+	assetOutAmount, swapFees, err := quote.SwapOut(inputSupply, outputSupply, assetInAmount)
+	if err != nil {
+		return nil, err
 	}
 
-	bigInputSupply := utils.ToBigUint(inputSupply)
-	bigOutputSupply := utils.ToBigUint(outputSupply)
-	bigAssetInAmount := utils.ToBigUint(assetInAmount)
-	k := utils.BigIntMul(bigInputSupply, bigOutputSupply)
-	bigAssetInAmountMinusFee := utils.BigIntDiv(
-		utils.BigIntMul(bigAssetInAmount, utils.ToBigUint(997)),
-		utils.ToBigUint(1000),
-	)
-	swapFees := assetInAmount - bigAssetInAmountMinusFee.Uint64()
-	bigAssetOutAmount := utils.BigIntSub(
-		bigOutputSupply,
-		utils.BigIntDiv(k, utils.BigIntAdd(bigInputSupply, bigAssetInAmountMinusFee)),
-	)
 	amountOut := &types.AssetAmount{
 		Asset:  assetOut,
-		Amount: bigAssetOutAmount.Uint64(),
+		Amount: assetOutAmount,
 	}
 
-	return &types.SwapQuote{
+	swapQuote := &types.SwapQuote{
 		SwapType:  constants.SwapFixedInput,
 		AmountIn:  amountIn,
 		AmountOut: amountOut,
@@ -64,6 +59,12 @@ func (p *Pool) FetchFixedInputSwapQuote(ctx context.Context, amountIn *types.Ass
 			Asset:  amountIn.Asset,
 			Amount: swapFees,
 		},
-		Slippage: slippage,
-	}, nil
+		SlippageBps: slippageBps,
+	}
+	quote.SetExecution(swapQuote, inputSupply, outputSupply)
+	if err := swapQuote.SetBounds(); err != nil {
+		return nil, err
+	}
+
+	return swapQuote, nil
 }
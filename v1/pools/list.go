@@ -0,0 +1,103 @@
+package pools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+)
+
+// ListOptions represents filtering and pagination options for listing pools
+type ListOptions struct {
+	// AssetIDs keeps only pools containing every given asset id
+	AssetIDs []uint64
+
+	// Limit is the maximum number of accounts read from the source, zero means no limit.
+	// Users are opted into the validator app as well, so a page may contain fewer pools than the limit.
+	Limit uint64
+
+	// Next is a token returned by a previous call, empty means the first page
+	Next string
+}
+
+// List lists pools of the validator app found in a source and returns a token of the next page
+func List(
+	ctx context.Context,
+	ac types.AlgodAPI,
+	source Source,
+	validatorAppID uint64,
+	userAddress string,
+	opts *ListOptions,
+) ([]*Pool, string, error) {
+	if source == nil {
+		return nil, "", fmt.Errorf("pool source is required")
+	}
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+
+	accounts, next, err := source.ListAccounts(ctx, validatorAppID, opts.Limit, opts.Next)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var listed []*Pool
+	for _, account := range accounts {
+		info, err := poolInfoFromValidatorAccount(account, validatorAppID)
+		if err != nil {
+			return nil, "", err
+		}
+		if info == nil || !hasAssets(info, opts.AssetIDs) {
+			continue
+		}
+
+		assetA := &types.Asset{ID: info.Asset1ID}
+		assetB := &types.Asset{ID: info.Asset2ID}
+		pool, err := NewPool(ctx, ac, assetA, assetB, info, validatorAppID, userAddress, false)
+		if err != nil {
+			return nil, "", err
+		}
+
+		listed = append(listed, pool)
+	}
+
+	return listed, next, nil
+}
+
+// poolInfoFromValidatorAccount returns pool information of a pool account, or nil if the account is not a pool
+func poolInfoFromValidatorAccount(account models.Account, validatorAppID uint64) (*types.PoolInfo, error) {
+	for _, as := range account.AppsLocalState {
+		if as.Id != validatorAppID {
+			continue
+		}
+
+		validatorAppState := make(map[string]models.TealValue)
+		for _, kv := range as.KeyValue {
+			validatorAppState[kv.Key] = kv.Value
+		}
+
+		// only pools have asset ids in their local state, the asset1 id is never Algo
+		if utils.StateInt(validatorAppState, "a1") == 0 {
+			return nil, nil
+		}
+
+		account.AppsLocalState = []models.ApplicationLocalState{as}
+
+		return poolInfoFromAccountInfo(account)
+	}
+
+	return nil, nil
+}
+
+func hasAssets(info *types.PoolInfo, assetIDs []uint64) bool {
+	for _, assetID := range assetIDs {
+		if info.Asset1ID != assetID && info.Asset2ID != assetID {
+			return false
+		}
+	}
+
+	return true
+}
@@ -26,7 +26,7 @@ func (p *Pool) PrepareMintTransactions(
 		minterAddress = p.UserAddress
 	}
 
-	sp, err := p.ac.SuggestedParams().Do(ctx)
+	sp, err := p.ac.SuggestedParams(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -55,10 +55,25 @@ func (p *Pool) PrepareMintTransactionsFromQuote(ctx context.Context, quote *type
 		return nil, fmt.Errorf("quote is required")
 	}
 
+	liquidityAssetAmount, err := quote.LiquidityAssetAmountWithSlippage()
+	if err != nil {
+		return nil, err
+	}
+
 	return p.PrepareMintTransactions(
 		ctx,
 		quote.AmountsIn,
-		&quote.LiquidityAssetAmount,
+		liquidityAssetAmount,
 		minterAddress,
 	)
 }
+
+// PrepareMintPlanFromQuote prepares mint transactions from a given mint quote, together with the app and asset opt-ins the user is missing
+func (p *Pool) PrepareMintPlanFromQuote(ctx context.Context, quote *types.MintQuote, minterAddress string) (*utils.TransactionPlan, error) {
+	txGroup, err := p.PrepareMintTransactionsFromQuote(ctx, quote, minterAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.PrepareOptInPlan(ctx, txGroup, minterAddress)
+}
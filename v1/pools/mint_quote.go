@@ -3,27 +3,35 @@ package pools
 import (
 	"context"
 	"fmt"
-	"math"
 
 	"github.com/synycboom/tinyman-go-sdk/types"
-	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/quote"
 )
 
-// FetchMintQuote returns a mint quote
+// FetchMintQuote refreshes the pool and returns a mint quote, a pool using a cache is only refreshed when its cached state is stale
 func (p *Pool) FetchMintQuote(
 	ctx context.Context,
 	amountA *types.AssetAmount,
 	amountB *types.AssetAmount,
-	slippage float64,
+	slippageBps uint64,
 ) (*types.MintQuote, error) {
 	if amountA == nil {
 		return nil, fmt.Errorf("amountA is required")
 	}
-	if slippage == 0 {
-		slippage = 0.05
+
+	if err := p.RefreshIfStale(ctx); err != nil {
+		return nil, err
+	}
+
+	return p.MintQuote(amountA, amountB, slippageBps)
+}
+
+// MintQuote returns a mint quote from the current pool state without refreshing it
+func (p *Pool) MintQuote(amountA *types.AssetAmount, amountB *types.AssetAmount, slippageBps uint64) (*types.MintQuote, error) {
+	if amountA == nil {
+		return nil, fmt.Errorf("amountA is required")
 	}
 
-	var liquidityAssetAmount uint64
 	var amount1 *types.AssetAmount
 	var amount2 *types.AssetAmount
 	if amountA.Asset.Equal(p.Asset1) {
@@ -34,12 +42,8 @@ func (p *Pool) FetchMintQuote(
 		amount2 = amountA
 	}
 
-	if err := p.Refresh(ctx, nil); err != nil {
-		return nil, err
-	}
-
 	if !p.exists {
-		return nil, fmt.Errorf("pool has not been bootstrapped yet")
+		return nil, types.ErrPoolNotBootstrapped
 	}
 
 	if p.IssuedLiquidity > 0 {
@@ -59,44 +63,20 @@ func (p *Pool) FetchMintQuote(
 
 			amount2 = amount
 		}
-
-		liquidityAssetAmount = uint64(
-			math.Min(
-				float64(utils.BigIntDiv(
-					utils.BigIntMul(
-						utils.ToBigUint(amount1.Amount),
-						utils.ToBigUint(p.IssuedLiquidity),
-					),
-					utils.ToBigUint(p.Asset1Reserves),
-				).Uint64()),
-				float64(utils.BigIntDiv(
-					utils.BigIntMul(
-						utils.ToBigUint(amount2.Amount),
-						utils.ToBigUint(p.IssuedLiquidity),
-					),
-					utils.ToBigUint(p.Asset2Reserves),
-				).Uint64()),
-			),
-		)
 	} else {
 		if amount1 == nil || amount2 == nil {
 			return nil, fmt.Errorf("amounts required for both assets for first mint")
 		}
 
-		liquidityAssetAmount = utils.BigIntSub(
-			utils.BigIntSqrt(
-				utils.BigIntMul(
-					utils.ToBigUint(amount1.Amount),
-					utils.ToBigUint(amount2.Amount),
-				),
-			),
-			utils.ToBigUint(1000),
-		).Uint64()
+		slippageBps = 0
+	}
 
-		slippage = 0
+	liquidityAssetAmount, err := quote.MintLiquidity(p.Asset1Reserves, p.Asset2Reserves, p.IssuedLiquidity, amount1.Amount, amount2.Amount)
+	if err != nil {
+		return nil, err
 	}
 
-	return &types.MintQuote{
+	mintQuote := &types.MintQuote{
 		AmountsIn: map[uint64]types.AssetAmount{
 			p.Asset1.ID: *amount1,
 			p.Asset2.ID: *amount2,
@@ -105,6 +85,11 @@ func (p *Pool) FetchMintQuote(
 			Asset:  p.LiquidityAsset,
 			Amount: liquidityAssetAmount,
 		},
-		Slippage: slippage,
-	}, nil
+		SlippageBps: slippageBps,
+	}
+	if err := mintQuote.SetBounds(); err != nil {
+		return nil, err
+	}
+
+	return mintQuote, nil
 }
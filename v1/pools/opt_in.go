@@ -2,6 +2,9 @@ package pools
 
 import (
 	"context"
+	"fmt"
+
+	algoTypes "github.com/algorand/go-algorand-sdk/types"
 
 	"github.com/synycboom/tinyman-go-sdk/utils"
 	"github.com/synycboom/tinyman-go-sdk/v1/prepare"
@@ -13,7 +16,7 @@ func (p *Pool) PrepareLiquidityAssetOptInTransactions(ctx context.Context, userA
 		userAddress = p.UserAddress
 	}
 
-	sp, err := p.ac.SuggestedParams().Do(ctx)
+	sp, err := p.ac.SuggestedParams(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -25,3 +28,136 @@ func (p *Pool) PrepareLiquidityAssetOptInTransactions(ctx context.Context, userA
 
 	return txGroup, nil
 }
+
+// PrepareOptInPlan checks a user account and returns a plan which opts the user in to the validator app and the assets received by a transaction group.
+// The opt-ins are added to the group itself when it does not call the validator app, is not signed yet and has room for them.
+// Otherwise, they are submitted as a pre-flight group since the validator app only accepts groups of an exact shape.
+func (p *Pool) PrepareOptInPlan(ctx context.Context, txGroup *utils.TransactionGroup, userAddress string) (*utils.TransactionPlan, error) {
+	if txGroup == nil {
+		return nil, fmt.Errorf("txGroup is required")
+	}
+	if len(userAddress) == 0 {
+		userAddress = p.UserAddress
+	}
+
+	optIns, err := p.missingOptIns(ctx, userAddress, txGroup)
+	if err != nil {
+		return nil, err
+	}
+	if len(optIns) == 0 {
+		return utils.NewTransactionPlan(txGroup), nil
+	}
+
+	callsValidator := false
+	for _, tx := range txGroup.Transactions() {
+		callsValidator = callsValidator || (tx.Type == algoTypes.ApplicationCallTx && uint64(tx.ApplicationID) == p.ValidatorAppID)
+	}
+
+	signed := false
+	for _, stx := range txGroup.SignedTransactions() {
+		signed = signed || len(stx) > 0
+	}
+	if !callsValidator && !signed && len(optIns)+len(txGroup.Transactions()) <= algoTypes.MaxTxGroupSize {
+		combined, err := utils.NewTransactionGroup(append(optIns, ungrouped(txGroup.Transactions())...))
+		if err != nil {
+			return nil, err
+		}
+
+		return utils.NewTransactionPlan(combined), nil
+	}
+
+	preflight, err := utils.NewTransactionGroup(optIns)
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.NewTransactionPlan(preflight, txGroup), nil
+}
+
+// missingOptIns returns ungrouped opt-in transactions of the validator app and the assets which a user is missing for given transaction groups
+func (p *Pool) missingOptIns(ctx context.Context, userAddress string, txGroups ...*utils.TransactionGroup) ([]algoTypes.Transaction, error) {
+	user, err := algoTypes.DecodeAddress(userAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := p.ac.AccountInformation(ctx, userAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	optedInApp := false
+	for _, ls := range account.AppsLocalState {
+		if ls.Id == p.ValidatorAppID {
+			optedInApp = true
+		}
+	}
+	optedInAssets := make(map[uint64]bool)
+	for _, holding := range account.Assets {
+		optedInAssets[holding.AssetId] = true
+	}
+
+	needsApp := false
+	var assetIDs []uint64
+	for _, txGroup := range txGroups {
+		for _, tx := range txGroup.Transactions() {
+			switch tx.Type {
+			case algoTypes.ApplicationCallTx:
+				if uint64(tx.ApplicationID) == p.ValidatorAppID {
+					needsApp = needsApp || tx.OnCompletion != algoTypes.OptInOC
+				}
+			case algoTypes.AssetTransferTx:
+				assetID := uint64(tx.XferAsset)
+				if tx.AssetReceiver == user && tx.Sender != user && !optedInAssets[assetID] {
+					optedInAssets[assetID] = true
+					assetIDs = append(assetIDs, assetID)
+				}
+			}
+		}
+	}
+
+	if (!needsApp || optedInApp) && len(assetIDs) == 0 {
+		return nil, nil
+	}
+
+	sp, err := p.ac.SuggestedParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var optInGroups []*utils.TransactionGroup
+	if needsApp && !optedInApp {
+		optInGroup, err := prepare.AppOptInTransactions(p.ValidatorAppID, userAddress, sp)
+		if err != nil {
+			return nil, err
+		}
+
+		optInGroups = append(optInGroups, optInGroup)
+	}
+	for _, assetID := range assetIDs {
+		optInGroup, err := prepare.AssetOptInTransactions(assetID, userAddress, sp)
+		if err != nil {
+			return nil, err
+		}
+
+		optInGroups = append(optInGroups, optInGroup)
+	}
+
+	var optIns []algoTypes.Transaction
+	for _, optInGroup := range optInGroups {
+		optIns = append(optIns, ungrouped(optInGroup.Transactions())...)
+	}
+
+	return optIns, nil
+}
+
+// ungrouped returns copies of transactions without their group id
+func ungrouped(txs []algoTypes.Transaction) []algoTypes.Transaction {
+	res := make([]algoTypes.Transaction, len(txs))
+	for idx, tx := range txs {
+		tx.Group = algoTypes.Digest{}
+		res[idx] = tx
+	}
+
+	return res
+}
@@ -5,25 +5,27 @@ import (
 	"fmt"
 
 	"github.com/synycboom/tinyman-go-sdk/types"
-	"github.com/synycboom/tinyman-go-sdk/utils"
 	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+	"github.com/synycboom/tinyman-go-sdk/v1/quote"
 )
 
-// FetchFixedOutputSwapQuote returns a fixed input swap quote
-func (p *Pool) FetchFixedOutputSwapQuote(ctx context.Context, amountOut *types.AssetAmount, slippage float64) (*types.SwapQuote, error) {
-	if amountOut == nil {
-		return nil, fmt.Errorf("amountOut is required")
+// FetchFixedOutputSwapQuote refreshes the pool and returns a fixed output swap quote, a pool using a cache is only refreshed when its cached state is stale
+func (p *Pool) FetchFixedOutputSwapQuote(ctx context.Context, amountOut *types.AssetAmount, slippageBps uint64) (*types.SwapQuote, error) {
+	if err := p.RefreshIfStale(ctx); err != nil {
+		return nil, err
 	}
 
-	if slippage == 0 {
-		slippage = 0.05
+	return p.FixedOutputSwapQuote(amountOut, slippageBps)
+}
+
+// FixedOutputSwapQuote returns a fixed output swap quote from the current pool state without refreshing it
+func (p *Pool) FixedOutputSwapQuote(amountOut *types.AssetAmount, slippageBps uint64) (*types.SwapQuote, error) {
+	if amountOut == nil {
+		return nil, fmt.Errorf("amountOut is required")
 	}
 
 	assetOut := amountOut.Asset
 	assetOutAmount := amountOut.Amount
-	if err := p.Refresh(ctx, nil); err != nil {
-		return nil, err
-	}
 
 	var assetIn *types.Asset
 	var inputSupply uint64
@@ -32,42 +34,38 @@ func (p *Pool) FetchFixedOutputSwapQuote(ctx context.Context, amountOut *types.A
 		assetIn = p.Asset2
 		inputSupply = p.Asset2Reserves
 		outputSupply = p.Asset1Reserves
-	} else {
+	} else if assetOut.Equal(p.Asset2) {
 		assetIn = p.Asset1
 		inputSupply = p.Asset1Reserves
 		outputSupply = p.Asset2Reserves
+	} else {
+		return nil, types.ErrAssetMismatch
 	}
 
-	if inputSupply == 0 || outputSupply == 0 {
-		return nil, fmt.Errorf("pool has no liquidity")
+	assetInAmount, swapFees, err := quote.SwapIn(inputSupply, outputSupply, assetOutAmount)
+	if err != nil {
+		return nil, err
 	}
 
-	bigInputSupply := utils.ToBigUint(inputSupply)
-	bigOutputSupply := utils.ToBigUint(outputSupply)
-	bigAssetOutAmount := utils.ToBigUint(assetOutAmount)
-	k := utils.BigIntMul(bigInputSupply, bigOutputSupply)
-	bigCalculatedAmountInWithoutFee := utils.BigIntSub(
-		utils.BigIntDiv(k, utils.BigIntSub(bigOutputSupply, bigAssetOutAmount)),
-		bigInputSupply,
-	)
-	bigAssetInAmount := utils.BigIntDiv(
-		utils.BigIntMul(bigCalculatedAmountInWithoutFee, utils.ToBigUint(1000)),
-		utils.ToBigUint(997),
-	)
-	bigSwapFees := utils.BigIntSub(bigAssetInAmount, bigCalculatedAmountInWithoutFee)
 	amountIn := types.AssetAmount{
 		Asset:  assetIn,
-		Amount: bigAssetInAmount.Uint64(),
+		Amount: assetInAmount,
 	}
 
-	return &types.SwapQuote{
+	swapQuote := &types.SwapQuote{
 		SwapType:  constants.SwapFixedOutput,
 		AmountIn:  &amountIn,
 		AmountOut: amountOut,
 		SwapFee: &types.AssetAmount{
 			Asset:  amountIn.Asset,
-			Amount: bigSwapFees.Uint64(),
+			Amount: swapFees,
 		},
-		Slippage: slippage,
-	}, nil
+		SlippageBps: slippageBps,
+	}
+	quote.SetExecution(swapQuote, inputSupply, outputSupply)
+	if err := swapQuote.SetBounds(); err != nil {
+		return nil, err
+	}
+
+	return swapQuote, nil
 }
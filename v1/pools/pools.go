@@ -4,19 +4,19 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/algorand/go-algorand-sdk/client/v2/algod"
 	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
 	"github.com/algorand/go-algorand-sdk/crypto"
 
 	"github.com/synycboom/tinyman-go-sdk/types"
 	"github.com/synycboom/tinyman-go-sdk/utils"
-	"github.com/synycboom/tinyman-go-sdk/v1/constants"
 	"github.com/synycboom/tinyman-go-sdk/v1/contracts"
+	"github.com/synycboom/tinyman-go-sdk/v1/quote"
 )
 
 // Pool represents a liquidity pool
 type Pool struct {
-	ac     *algod.Client
+	ac     types.AlgodAPI
+	cache  *StateCache
 	exists bool
 
 	ValidatorAppID                  uint64
@@ -36,9 +36,11 @@ type Pool struct {
 	UserAddress                     string
 }
 
+// NewPool creates a pool of two assets and fetches the assets when they are missing details,
+// an algod client wrapped by types.NewAssetRegistry shares cached assets between pools
 func NewPool(
 	ctx context.Context,
-	ac *algod.Client,
+	ac types.AlgodAPI,
 	assetA,
 	assetB *types.Asset,
 	info *types.PoolInfo,
@@ -92,7 +94,7 @@ func NewPool(
 }
 
 // FromAccountInfo create a pool from an account
-func FromAccountInfo(ctx context.Context, account models.Account, ac *algod.Client, userAddress string) (*Pool, error) {
+func FromAccountInfo(ctx context.Context, account models.Account, ac types.AlgodAPI, userAddress string) (*Pool, error) {
 	info, err := poolInfoFromAccountInfo(account)
 	if err != nil {
 		return nil, err
@@ -123,20 +125,114 @@ func (p *Pool) Refresh(ctx context.Context, info *types.PoolInfo) error {
 		return err
 	}
 
+	if p.cache != nil {
+		p.cache.Put(info)
+	}
+
 	return nil
 }
 
-// UpdateFromInfo updates pool information from a given pool info
+// UseCache makes the pool store refreshed states in a cache and read them back in RefreshIfStale and the Fetch quote functions
+func (p *Pool) UseCache(cache *StateCache) {
+	p.cache = cache
+}
+
+// RefreshIfStale updates the pool from its cached state when it is fresh, otherwise it refreshes the pool.
+// The cache is advanced to the current round of the node first, so trades of other accounts make cached states stale.
+// A node without types.StatusAPI cannot tell the current round, then only a cache with a MaxAge is trusted.
+func (p *Pool) RefreshIfStale(ctx context.Context) error {
+	if p.cache == nil {
+		return p.Refresh(ctx, nil)
+	}
+
+	status, ok := statusAPI(p.ac)
+	if !ok && p.cache.MaxAge == 0 {
+		return p.Refresh(ctx, nil)
+	}
+	if ok {
+		nodeStatus, err := status.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		p.cache.Advance(nodeStatus.LastRound)
+	}
+
+	address, err := p.Address()
+	if err != nil {
+		return err
+	}
+
+	info, ok := p.cache.Get(address)
+	if !ok {
+		return p.Refresh(ctx, nil)
+	}
+	if p.LiquidityAsset != nil && p.LiquidityAsset.ID == info.LiquidityAssetID {
+		p.applyInfo(info)
+
+		return nil
+	}
+
+	return p.UpdateFromInfo(ctx, info)
+}
+
+// FromCache returns a copy of the pool updated from its cached state without network access, it is false when the cache has no fresh state
+func (p *Pool) FromCache() (*Pool, bool) {
+	if p.cache == nil {
+		return nil, false
+	}
+
+	address, err := p.Address()
+	if err != nil {
+		return nil, false
+	}
+
+	info, ok := p.cache.Get(address)
+	if !ok {
+		return nil, false
+	}
+
+	return p.WithInfo(info), true
+}
+
+// WithInfo returns a copy of the pool updated from a given pool info without network access,
+// the liquidity asset keeps its fetched details when the info refers to the same asset
+func (p *Pool) WithInfo(info *types.PoolInfo) *Pool {
+	pool := *p
+	pool.applyInfo(info)
+
+	return &pool
+}
+
+// UpdateFromInfo updates pool information from a given pool info,
+// the liquidity asset is only looked up when the info does not carry its name
 func (p *Pool) UpdateFromInfo(ctx context.Context, info *types.PoolInfo) error {
+	p.applyInfo(info)
+	if p.IssuedLiquidity > 0 && p.LiquidityAsset.IsFetchingRequired() {
+		asset := types.Asset{ID: p.LiquidityAsset.ID}
+		if err := asset.Fetch(ctx, p.ac); err != nil {
+			return err
+		}
+
+		p.LiquidityAsset = &asset
+	}
+
+	return nil
+}
+
+// applyInfo updates pool information from a given pool info without fetching the liquidity asset
+func (p *Pool) applyInfo(info *types.PoolInfo) {
 	if info.LiquidityAssetID > 0 {
 		p.exists = true
 	}
 
-	p.LiquidityAsset = &types.Asset{
-		ID:       info.LiquidityAssetID,
-		Decimals: 6,
-		Name:     info.LiquidityAssetName,
-		UnitName: constants.LiquidityAssetUnitName,
+	if p.LiquidityAsset == nil || p.LiquidityAsset.ID != info.LiquidityAssetID {
+		p.LiquidityAsset = &types.Asset{
+			ID:       info.LiquidityAssetID,
+			Decimals: 6,
+			Name:     info.LiquidityAssetName,
+			UnitName: contracts.LiquidityAssetUnitName(p.ValidatorAppID),
+		}
 	}
 	p.Asset1Reserves = info.Asset1Reserves
 	p.Asset2Reserves = info.Asset2Reserves
@@ -151,40 +247,11 @@ func (p *Pool) UpdateFromInfo(ctx context.Context, info *types.PoolInfo) error {
 	if p.Asset2.ID == 0 {
 		p.Asset2Reserves = (p.AlgoBalance - p.MinBalance) - p.OutstandingAsset2Amount
 	}
-	if p.IssuedLiquidity > 0 {
-		asset, err := p.ac.GetAssetByID(p.LiquidityAsset.ID).Do(ctx)
-		if err != nil {
-			return err
-		}
-
-		p.LiquidityAsset = &types.Asset{
-			ID:       asset.Index,
-			Decimals: asset.Params.Decimals,
-			Name:     asset.Params.Name,
-			UnitName: asset.Params.UnitName,
-		}
-	}
-
-	return nil
 }
 
 // MinimumBalance calculates minimum balance
 func (p *Pool) MinimumBalance() uint64 {
-	numAssets := 3
-	if p.Asset2.ID == 0 {
-		numAssets = 2
-	}
-
-	numCreatedApps := 0
-	numLocalApps := 1
-	totalUints := 16
-	totalByteSlices := 0
-
-	return uint64(constants.MinBalancePerAccount +
-		(constants.MinBalancePerAsset * numAssets) +
-		(constants.MinBalancePerApp * (numCreatedApps + numLocalApps)) +
-		(constants.MinBalancePerAppUint * totalUints) +
-		(constants.MinBalancePerAppByteSlice * totalByteSlices))
+	return quote.MinimumBalance(p.Asset2.ID)
 }
 
 // LogicSig returns a logic signature account
@@ -275,7 +342,7 @@ func (p *Pool) Convert(amount *types.AssetAmount) (*types.AssetAmount, error) {
 		}, nil
 	}
 
-	return nil, fmt.Errorf("mismatch asset")
+	return nil, types.ErrAssetMismatch
 }
 
 // FetchStateInt returns an application state int value of the pool by a given key
@@ -285,13 +352,13 @@ func (p *Pool) FetchStateInt(ctx context.Context, key string) (uint64, error) {
 		return 0, err
 	}
 
-	accountInfo, err := p.ac.AccountInformation(poolAddress).Do(ctx)
+	accountInfo, err := p.ac.AccountInformation(ctx, poolAddress)
 	if err != nil {
 		return 0, err
 	}
 
 	if len(accountInfo.AppsLocalState) == 0 {
-		return 0, fmt.Errorf("application has no local state")
+		return 0, fmt.Errorf("pool account has no local state: %w", types.ErrPoolNotBootstrapped)
 	}
 
 	validatorAppState := make(map[string]models.TealValue)
@@ -309,13 +376,13 @@ func (p *Pool) FetchStateBytes(ctx context.Context, key string) ([]byte, error)
 		return nil, err
 	}
 
-	accountInfo, err := p.ac.AccountInformation(poolAddress).Do(ctx)
+	accountInfo, err := p.ac.AccountInformation(ctx, poolAddress)
 	if err != nil {
 		return nil, err
 	}
 
 	if len(accountInfo.AppsLocalState) == 0 {
-		return nil, fmt.Errorf("application has no local state")
+		return nil, fmt.Errorf("pool account has no local state: %w", types.ErrPoolNotBootstrapped)
 	}
 
 	validatorAppState := make(map[string]models.TealValue)
@@ -325,3 +392,14 @@ func (p *Pool) FetchStateBytes(ctx context.Context, key string) ([]byte, error)
 
 	return utils.StateBytes(validatorAppState, key), nil
 }
+
+// statusAPI returns the status API of an algod client, a client wrapped by an asset registry is unwrapped
+func statusAPI(ac types.AlgodAPI) (types.StatusAPI, bool) {
+	if registry, ok := ac.(*types.AssetRegistry); ok {
+		ac = registry.Algod()
+	}
+
+	status, ok := ac.(types.StatusAPI)
+
+	return status, ok
+}
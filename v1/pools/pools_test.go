@@ -0,0 +1,642 @@
+package pools_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+	"github.com/synycboom/tinyman-go-sdk/v1/pools"
+	"github.com/synycboom/tinyman-go-sdk/v1/simulator"
+	"github.com/synycboom/tinyman-go-sdk/v1/tinymantest"
+)
+
+const (
+	validatorAppID   = constants.TestnetValidatorAppId
+	usdcID           = uint64(10458941)
+	liquidityAssetID = uint64(62368708)
+	usdtID           = uint64(21582668)
+)
+
+// newFixture seeds an ALGO/USDC pool with reserves of 2000 USDC and 1000 ALGO
+func newFixture(t *testing.T) (*tinymantest.Fixture, *pools.Pool) {
+	e := tinymantest.New(t)
+	e.Sim.SetAsset(usdcID, 6, "USDC", "USDC")
+	pool := e.SeedPool(types.PoolInfo{
+		Asset1ID:         usdcID,
+		Asset2ID:         0,
+		LiquidityAssetID: liquidityAssetID,
+		Asset1Reserves:   2000000000,
+		Asset2Reserves:   1000000000,
+		IssuedLiquidity:  1000000000,
+		ValidatorAppID:   validatorAppID,
+		AlgoBalance:      1000000000 + 100000 + 2*100000 + 100000 + 16*28500,
+	})
+
+	return e, pool
+}
+
+// fetchPool fetches another instance of the ALGO/USDC pool which does not share state with the seeded one
+func fetchPool(t *testing.T, e *tinymantest.Fixture) *pools.Pool {
+	usdc := &types.Asset{ID: usdcID}
+	algo := &types.Asset{ID: 0}
+	pool, err := pools.NewPool(e.Ctx, e.Sim, usdc, algo, nil, validatorAppID, e.Address(), true)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	return pool
+}
+
+func TestNewPool(t *testing.T) {
+	_, pool := newFixture(t)
+	if pool.Asset1.ID != usdcID || pool.Asset2.ID != 0 {
+		t.Errorf("NewPool returned wrong assets")
+	}
+	if pool.Asset1Reserves != 2000000000 || pool.Asset2Reserves != 1000000000 {
+		t.Errorf("NewPool returned wrong reserves %d %d", pool.Asset1Reserves, pool.Asset2Reserves)
+	}
+	if pool.LiquidityAsset.ID != liquidityAssetID {
+		t.Errorf("NewPool returned wrong liquidity asset %d", pool.LiquidityAsset.ID)
+	}
+}
+
+func TestFetchFixedInputSwapQuote(t *testing.T) {
+	_, pool := newFixture(t)
+	quote, err := pool.FetchFixedInputSwapQuote(context.Background(), &types.AssetAmount{
+		Asset:  pool.Asset2,
+		Amount: 1000000,
+	}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	// 997 * 1000000 * 2000000000 / (1000 * 1000000000 + 997 * 1000000)
+	if quote.AmountOut.Amount != 1992013 {
+		t.Errorf("FetchFixedInputSwapQuote returned wrong amount out %d", quote.AmountOut.Amount)
+	}
+	if quote.SwapFee.Amount != 3000 {
+		t.Errorf("FetchFixedInputSwapQuote returned wrong swap fee %d", quote.SwapFee.Amount)
+	}
+}
+
+func TestSubmitSwap(t *testing.T) {
+	e, pool := newFixture(t)
+	e.OptIn()
+	if err := e.Sim.SetBalance(e.Address(), usdcID, 0); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	quote, err := pool.FetchFixedInputSwapQuote(e.Ctx, &types.AssetAmount{
+		Asset:  pool.Asset2,
+		Amount: 1000000,
+	}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	txGroup, err := pool.PrepareSwapTransactionsFromQuote(e.Ctx, quote, "")
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	if err := txGroup.Sign(&e.User); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if _, err := txGroup.Submit(e.Ctx, e.Sim, true); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	sent := e.Sim.SentTransactions()
+	if len(sent) != 2 || len(sent[1]) != 4 {
+		t.Errorf("Submit sent wrong transactions")
+	}
+	if received := e.Sim.Balance(e.Address(), usdcID); received != quote.MinAmountOut.Amount {
+		t.Errorf("The seeded pool sent %d instead of %d", received, quote.MinAmountOut.Amount)
+	}
+}
+
+func TestSwapReservesAfter(t *testing.T) {
+	e, pool := newFixture(t)
+	e.OptIn()
+	if err := e.Sim.SetBalance(e.Address(), usdcID, 100000000); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	// the pool keeps the full input of a fixed input swap and only the required input of a fixed output swap,
+	// the protocol fee is minted as liquidity so it stays in the reserves
+	amount := &types.AssetAmount{Asset: pool.Asset2, Amount: 1000000}
+	for _, fetch := range []func(context.Context, *types.AssetAmount, uint64) (*types.SwapQuote, error){
+		pool.FetchFixedInputSwapQuote,
+		pool.FetchFixedOutputSwapQuote,
+	} {
+		quote, err := fetch(e.Ctx, amount, 100)
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		txGroup, err := pool.PrepareSwapTransactionsFromQuote(e.Ctx, quote, "")
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+		if err := txGroup.Sign(&e.User); err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+		if _, err := txGroup.Submit(e.Ctx, e.Sim, true); err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		executed := fetchPool(t, e)
+		if quote.ReservesAfter[pool.Asset1.ID].Amount != executed.Asset1Reserves || quote.ReservesAfter[pool.Asset2.ID].Amount != executed.Asset2Reserves {
+			t.Errorf("Quote returned reserves %d %d instead of %d %d", quote.ReservesAfter[pool.Asset1.ID].Amount,
+				quote.ReservesAfter[pool.Asset2.ID].Amount, executed.Asset1Reserves, executed.Asset2Reserves)
+		}
+	}
+}
+
+// listFixture seeds a USDT/USDC pool next to the ALGO/USDC pool and returns addresses of both pools and the user
+func listFixture(t *testing.T) (*tinymantest.Fixture, []string) {
+	e, usdcPool := newFixture(t)
+	e.Sim.SetAsset(usdtID, 6, "USDT", "USDT")
+	usdtPool := e.SeedPool(types.PoolInfo{
+		Asset1ID:         usdtID,
+		Asset2ID:         usdcID,
+		LiquidityAssetID: liquidityAssetID + 1,
+		Asset1Reserves:   1000000000,
+		Asset2Reserves:   1000000000,
+		IssuedLiquidity:  1000000000,
+		ValidatorAppID:   validatorAppID,
+		AlgoBalance:      1000000,
+	})
+
+	// users are opted into the validator app as well and must be skipped
+	e.OptIn()
+
+	var addresses []string
+	for _, pool := range []*pools.Pool{usdcPool, usdtPool} {
+		address, err := pool.Address()
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	return e, []string{addresses[0], e.Address(), addresses[1]}
+}
+
+func TestListFromIndexerSource(t *testing.T) {
+	ctx := context.Background()
+	e, _ := listFixture(t)
+	source := pools.NewIndexerSource(e.Sim)
+
+	var listed []*pools.Pool
+	opts := &pools.ListOptions{Limit: 1}
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatalf("List did not stop paginating")
+		}
+
+		page, next, err := pools.List(ctx, e.Sim, source, validatorAppID, e.Address(), opts)
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		listed = append(listed, page...)
+		if len(next) == 0 {
+			break
+		}
+		opts.Next = next
+	}
+
+	if len(listed) != 2 {
+		t.Fatalf("List returned %d pools instead of 2", len(listed))
+	}
+	for _, pool := range listed {
+		if pool.Asset1Reserves == 0 || pool.LiquidityAsset.ID == 0 {
+			t.Errorf("List returned a pool without reserves")
+		}
+	}
+}
+
+func TestListFromStaticSource(t *testing.T) {
+	ctx := context.Background()
+	e, addresses := listFixture(t)
+	source := pools.NewStaticSource(e.Sim, addresses)
+
+	listed, next, err := pools.List(ctx, e.Sim, source, validatorAppID, e.Address(), &pools.ListOptions{
+		AssetIDs: []uint64{0},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(next) != 0 {
+		t.Errorf("List returned a next token for the last page")
+	}
+	if len(listed) != 1 || listed[0].Asset1.ID != usdcID || listed[0].Asset2.ID != 0 {
+		t.Fatalf("List returned wrong pools")
+	}
+	if listed[0].Asset2Reserves != 1000000000 {
+		t.Errorf("List returned wrong reserves %d", listed[0].Asset2Reserves)
+	}
+
+	listed, next, err = pools.List(ctx, e.Sim, source, validatorAppID, e.Address(), &pools.ListOptions{
+		AssetIDs: []uint64{usdcID},
+		Limit:    2,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(listed) != 1 || next != "2" {
+		t.Errorf("List returned wrong first page")
+	}
+}
+
+func randomPool(r *rand.Rand) *pools.Pool {
+	return &pools.Pool{
+		Asset1:         &types.Asset{ID: usdcID},
+		Asset2:         &types.Asset{ID: 0},
+		Asset1Reserves: uint64(r.Int63n(1e15)) + 1000,
+		Asset2Reserves: uint64(r.Int63n(1e15)) + 1000,
+	}
+}
+
+func TestFixedInputSwapQuoteConstantProduct(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		pool := randomPool(r)
+		amountIn := &types.AssetAmount{Asset: pool.Asset2, Amount: uint64(r.Int63n(int64(pool.Asset2Reserves))) + 1}
+		quote, err := pool.FixedInputSwapQuote(amountIn, uint64(r.Intn(1000)+1))
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		// the output is the largest amount keeping (1000 * s2 + 997 * in) * (s1 - out) >= 1000 * s1 * s2
+		k := new(big.Int).Mul(new(big.Int).SetUint64(pool.Asset1Reserves), new(big.Int).SetUint64(pool.Asset2Reserves))
+		k.Mul(k, big.NewInt(1000))
+		in := new(big.Int).SetUint64(pool.Asset2Reserves)
+		in.Mul(in, big.NewInt(1000))
+		in.Add(in, new(big.Int).Mul(new(big.Int).SetUint64(amountIn.Amount), big.NewInt(997)))
+		kept := new(big.Int).SetUint64(pool.Asset1Reserves - quote.AmountOut.Amount)
+		if new(big.Int).Mul(in, kept).Cmp(k) < 0 || new(big.Int).Mul(in, kept.Sub(kept, big.NewInt(1))).Cmp(k) >= 0 {
+			t.Fatalf("FixedInputSwapQuote breaks the constant product")
+		}
+
+		bps := quote.SlippageBps
+		if quote.MaxAmountIn.Amount != amountIn.Amount ||
+			quote.MinAmountOut.Amount*10000 > quote.AmountOut.Amount*(10000-bps) ||
+			(quote.MinAmountOut.Amount+1)*10000 <= quote.AmountOut.Amount*(10000-bps) {
+			t.Fatalf("FixedInputSwapQuote returned wrong bounds")
+		}
+	}
+}
+
+func TestFixedOutputSwapQuoteConstantProduct(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		pool := randomPool(r)
+		amountOut := &types.AssetAmount{Asset: pool.Asset1, Amount: uint64(r.Int63n(int64(pool.Asset1Reserves-1))) + 1}
+		quote, err := pool.FixedOutputSwapQuote(amountOut, uint64(r.Intn(1000)+1))
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		// the input keeps (s2 + in) * (s1 - out) >= s1 * s2, the input without fee is the smallest amount keeping it
+		k := new(big.Int).Mul(new(big.Int).SetUint64(pool.Asset1Reserves), new(big.Int).SetUint64(pool.Asset2Reserves))
+		out := new(big.Int).SetUint64(pool.Asset1Reserves - amountOut.Amount)
+		in := new(big.Int).SetUint64(pool.Asset2Reserves + quote.AmountIn.Amount)
+		if new(big.Int).Mul(in, out).Cmp(k) < 0 {
+			t.Fatalf("FixedOutputSwapQuote breaks the constant product")
+		}
+		inWithoutFee := new(big.Int).SetUint64(pool.Asset2Reserves + quote.AmountIn.Amount - quote.SwapFee.Amount)
+		if new(big.Int).Mul(inWithoutFee, out).Cmp(k) < 0 || new(big.Int).Mul(inWithoutFee.Sub(inWithoutFee, big.NewInt(1)), out).Cmp(k) >= 0 {
+			t.Fatalf("FixedOutputSwapQuote returned wrong swap fee %d", quote.SwapFee.Amount)
+		}
+
+		// the input is the smallest amount which the validator accepts: (1000 * s2 + 997 * in) * (s1 - out) > 1000 * s1 * s2
+		k.Mul(k, big.NewInt(1000))
+		scaled := func(amountIn uint64) *big.Int {
+			in := new(big.Int).Mul(new(big.Int).SetUint64(pool.Asset2Reserves), big.NewInt(1000))
+			in.Add(in, new(big.Int).Mul(new(big.Int).SetUint64(amountIn), big.NewInt(997)))
+
+			return in.Mul(in, out)
+		}
+		if scaled(quote.AmountIn.Amount).Cmp(k) <= 0 || scaled(quote.AmountIn.Amount-1).Cmp(k) > 0 {
+			t.Fatalf("FixedOutputSwapQuote returned wrong input %d", quote.AmountIn.Amount)
+		}
+
+		bps := quote.SlippageBps
+		maxIn := new(big.Int).SetUint64(quote.MaxAmountIn.Amount)
+		exact := new(big.Int).Mul(new(big.Int).SetUint64(quote.AmountIn.Amount), new(big.Int).SetUint64(10000+bps))
+		if quote.MinAmountOut.Amount != amountOut.Amount ||
+			new(big.Int).Mul(maxIn, big.NewInt(10000)).Cmp(exact) < 0 ||
+			new(big.Int).Mul(maxIn.Sub(maxIn, big.NewInt(1)), big.NewInt(10000)).Cmp(exact) >= 0 {
+			t.Fatalf("FixedOutputSwapQuote returned wrong bounds")
+		}
+	}
+}
+
+func TestStateCache(t *testing.T) {
+	ctx := context.Background()
+	e, pool := newFixture(t)
+	cache := pools.NewStateCache(1, 0)
+	pool.UseCache(cache)
+	if err := pool.Refresh(ctx, nil); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	info, err := pool.Info()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	info.Asset1Reserves = 4000000000
+	if _, err := e.Sim.SetPool(*info); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	amountIn := &types.AssetAmount{Asset: pool.Asset2, Amount: 1000000}
+	cache.Advance(2)
+	if _, err := pool.FetchFixedInputSwapQuote(ctx, amountIn, 100); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if pool.Asset1Reserves != 2000000000 {
+		t.Errorf("A fresh cached state should be used, got reserves %d", pool.Asset1Reserves)
+	}
+
+	offline := pool.WithInfo(info)
+	if offline.Asset1Reserves != 4000000000 || pool.Asset1Reserves != 2000000000 {
+		t.Errorf("WithInfo should only update a copy of the pool")
+	}
+	if offline.LiquidityAsset.Name != pool.LiquidityAsset.Name {
+		t.Errorf("WithInfo should keep the fetched liquidity asset")
+	}
+
+	e.Sim.SetRound(3)
+	cache.Advance(3)
+	if _, ok := pool.FromCache(); ok {
+		t.Errorf("A state older than MaxAgeRounds should be stale")
+	}
+	if _, err := pool.FetchFixedInputSwapQuote(ctx, amountIn, 100); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if pool.Asset1Reserves != 4000000000 {
+		t.Errorf("A stale cached state should be refreshed, got reserves %d", pool.Asset1Reserves)
+	}
+
+	cached, ok := pool.FromCache()
+	if !ok || cached.LastRefreshedRound != 3 {
+		t.Errorf("Refresh should store the state read at round 3")
+	}
+}
+
+func TestTradeSizeSolvers(t *testing.T) {
+	_, pool := newFixture(t)
+
+	maxIn, err := pool.MaxAmountInForPriceImpact(pool.Asset2, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	within, err := pool.FixedInputSwapQuote(maxIn, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	beyond, err := pool.FixedInputSwapQuote(&types.AssetAmount{Asset: pool.Asset2, Amount: maxIn.Amount + 1}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if within.PriceImpactBps > 100 || beyond.PriceImpactBps <= 100 {
+		t.Errorf("MaxAmountInForPriceImpact returned %d with an impact of %f bps", maxIn.Amount, within.PriceImpactBps)
+	}
+	if noFee, err := pool.MaxAmountInForPriceImpact(pool.Asset2, 20); err != nil || noFee.Amount != 0 {
+		t.Errorf("An impact below the swap fee should allow no input")
+	}
+
+	target := within.SpotPrice * 0.9
+	amountIn, err := pool.AmountInForPrice(pool.Asset2, target)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	reached, err := pool.FixedInputSwapQuote(amountIn, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	short, err := pool.FixedInputSwapQuote(&types.AssetAmount{Asset: pool.Asset2, Amount: amountIn.Amount - 1}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	price := func(q *types.SwapQuote) float64 {
+		return float64(q.ReservesAfter[pool.Asset1.ID].Amount) / float64(q.ReservesAfter[pool.Asset2.ID].Amount)
+	}
+	if price(reached) > target || price(short) <= target {
+		t.Errorf("AmountInForPrice returned %d which moves the price to %f instead of %f", amountIn.Amount, price(reached), target)
+	}
+
+	desired := &types.AssetAmount{Asset: pool.Asset1, Amount: 123456789}
+	amountIn, err = pool.AmountInForAmountOut(desired)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	exact, err := pool.FixedInputSwapQuote(amountIn, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	less, err := pool.FixedInputSwapQuote(&types.AssetAmount{Asset: pool.Asset2, Amount: amountIn.Amount - 1}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if exact.AmountOut.Amount < desired.Amount || less.AmountOut.Amount >= desired.Amount {
+		t.Errorf("AmountInForAmountOut returned %d which gives %d", amountIn.Amount, exact.AmountOut.Amount)
+	}
+}
+
+func TestRefreshAll(t *testing.T) {
+	ctx := context.Background()
+	e, _ := listFixture(t)
+	usdcPool := fetchPool(t, e)
+	usdtPool, err := pools.NewPool(ctx, e.Sim, &types.Asset{ID: usdtID}, &types.Asset{ID: usdcID}, nil, validatorAppID, e.Address(), true)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	info, err := usdtPool.Info()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	info.Asset1Reserves = 3000000000
+	if _, err := e.Sim.SetPool(*info); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	started := time.Now()
+	result := pools.RefreshAll(ctx, []*pools.Pool{usdcPool, usdtPool, fetchPool(t, e)}, &pools.RefreshOptions{
+		Concurrency:   2,
+		RatePerSecond: 20,
+	})
+	if err := result.Err(); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if elapsed := time.Since(started); elapsed < 90*time.Millisecond {
+		t.Errorf("3 refreshes at 20 per second should take at least 100ms, took %s", elapsed)
+	}
+	if result.Refreshed != 3 || len(result.Changed) != 1 || result.Changed[0] != usdtPool {
+		t.Fatalf("RefreshAll should report only the USDT pool as changed, got %d changed", len(result.Changed))
+	}
+	if usdtPool.Asset1Reserves != 3000000000 {
+		t.Errorf("RefreshAll did not refresh the pool, got reserves %d", usdtPool.Asset1Reserves)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	result = pools.RefreshAll(cancelled, []*pools.Pool{usdcPool, usdtPool}, nil)
+	if len(result.Errors) != 2 || !errors.Is(result.Err(), context.Canceled) {
+		t.Fatalf("RefreshAll should collect an error of every pool")
+	}
+}
+
+func TestWatcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	e, pool := newFixture(t)
+	cache := pools.NewStateCache(0, 0)
+	pool.UseCache(cache)
+	address, err := pool.Address()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	watcher, err := pools.NewWatcher(e.Sim, []*pools.Pool{pool}, []pools.PriceThreshold{
+		{PoolAddress: address, AssetID: usdcID, Price: 0.4},
+	}, 10)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- watcher.Run(ctx)
+	}()
+
+	info, err := pool.Info()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	// Wait until the watcher has read the first state before changing it
+	time.Sleep(20 * time.Millisecond)
+	info.Asset1Reserves = 3000000000
+	info.Asset2Reserves = 1000000000
+	info.IssuedLiquidity = 1500000000
+	if _, err := e.Sim.SetPool(*info); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	e.Sim.SetRound(2)
+
+	var got []pools.EventType
+	for len(got) < 3 {
+		select {
+		case event := <-watcher.Events():
+			if event.Round != 2 || event.Before.Asset1Reserves != 2000000000 || event.After.Asset1Reserves != 3000000000 {
+				t.Fatalf("Unexpected event %v", event)
+			}
+			if event.Type == pools.EventPriceCrossed && (event.PriceBefore != 0.5 || event.Threshold.AssetID != usdcID) {
+				t.Fatalf("Unexpected price crossing %v", event)
+			}
+
+			got = append(got, event.Type)
+		case <-time.After(time.Second):
+			t.Fatalf("Watcher emitted %d events instead of 3", len(got))
+		}
+	}
+	if got[0] != pools.EventReservesChanged || got[1] != pools.EventLiquidityIssued || got[2] != pools.EventPriceCrossed {
+		t.Errorf("Unexpected events %v", got)
+	}
+	if cached, ok := pool.FromCache(); !ok || cached.Asset1Reserves != 3000000000 {
+		t.Errorf("Watcher should store read states in the pool cache")
+	}
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Run should return the context error, got %v", err)
+	}
+	if _, ok := <-watcher.Events(); ok {
+		t.Errorf("Events should be closed when Run returns")
+	}
+}
+
+// flakyNode fails a given number of account reads before it answers them
+type flakyNode struct {
+	*simulator.Simulator
+
+	failures int32
+}
+
+func (n *flakyNode) AccountInformation(ctx context.Context, address string) (models.Account, error) {
+	if atomic.AddInt32(&n.failures, -1) >= 0 {
+		return models.Account{}, errors.New("HTTP 503: node is catching up")
+	}
+
+	return n.Simulator.AccountInformation(ctx, address)
+}
+
+func TestWatcherRetriesFailedReads(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	e, seeded := newFixture(t)
+	node := &flakyNode{Simulator: e.Sim}
+	pool, err := pools.NewPool(ctx, node, seeded.Asset1, seeded.Asset2, nil, validatorAppID, e.Address(), true)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	atomic.StoreInt32(&node.failures, 2)
+	watcher, err := pools.NewWatcher(node, []*pools.Pool{pool}, nil, 10)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	watcher.RetryInterval = time.Millisecond
+	go watcher.Run(ctx)
+
+	next := func() pools.Event {
+		select {
+		case event, ok := <-watcher.Events():
+			if !ok {
+				t.Fatalf("Events should stay open after a failed read")
+			}
+
+			return event
+		case <-time.After(time.Second):
+			t.Fatalf("Watcher emitted no event")
+		}
+
+		return pools.Event{}
+	}
+	for i := 0; i < 2; i++ {
+		if event := next(); event.Type != pools.EventReadFailed || event.Err == nil {
+			t.Fatalf("Unexpected event %v", event)
+		}
+	}
+
+	info, err := pool.Info()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	time.Sleep(20 * time.Millisecond)
+	info.Asset1Reserves = 3000000000
+	if _, err := e.Sim.SetPool(*info); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	e.Sim.SetRound(2)
+
+	if event := next(); event.Type != pools.EventReservesChanged || event.Round != 2 || event.After.Asset1Reserves != 3000000000 {
+		t.Errorf("Unexpected event %v", event)
+	}
+}
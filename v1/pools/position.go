@@ -13,7 +13,7 @@ func (p *Pool) FetchPoolPosition(ctx context.Context, userAddress string) (*type
 		userAddress = p.UserAddress
 	}
 
-	accountInfo, err := p.ac.AccountInformation(userAddress).Do(ctx)
+	accountInfo, err := p.ac.AccountInformation(ctx, userAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+
 	"github.com/synycboom/tinyman-go-sdk/types"
 	"github.com/synycboom/tinyman-go-sdk/utils"
 	"github.com/synycboom/tinyman-go-sdk/v1/prepare"
@@ -19,7 +21,7 @@ func (p *Pool) PrepareRedeemTransactions(ctx context.Context, amountOut *types.A
 		redeemerAddress = p.UserAddress
 	}
 
-	sp, err := p.ac.SuggestedParams().Do(ctx)
+	sp, err := p.ac.SuggestedParams(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -82,3 +84,48 @@ func (p *Pool) GetRedeemQuoteMatchesAssetID(assetID uint64, quotes []types.Redee
 
 	return nil, nil
 }
+
+// FetchExcessAmounts fetches excess amounts of a user in this pool and returns redeem quotes of the non-zero ones
+func (p *Pool) FetchExcessAmounts(ctx context.Context, userAddress string) ([]types.RedeemQuote, error) {
+	if len(userAddress) == 0 {
+		userAddress = p.UserAddress
+	}
+
+	poolAddress, err := p.Address()
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := p.ac.AccountInformation(ctx, userAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorAppState := make(map[string]models.TealValue)
+	for _, ls := range account.AppsLocalState {
+		if ls.Id != p.ValidatorAppID {
+			continue
+		}
+
+		for _, kv := range ls.KeyValue {
+			validatorAppState[kv.Key] = kv.Value
+		}
+	}
+
+	var quotes []types.RedeemQuote
+	for _, asset := range []*types.Asset{p.Asset1, p.Asset2, p.LiquidityAsset} {
+		key, err := utils.ExcessAssetStateKey(poolAddress, asset.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if amount := utils.StateInt(validatorAppState, string(key)); amount > 0 {
+			quotes = append(quotes, types.RedeemQuote{
+				Amount:      types.AssetAmount{Asset: asset, Amount: amount},
+				PoolAddress: poolAddress,
+			})
+		}
+	}
+
+	return quotes, nil
+}
@@ -0,0 +1,175 @@
+package pools
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// DefaultRefreshConcurrency is the number of pools RefreshAll refreshes at the same time when no concurrency is given
+const DefaultRefreshConcurrency = 8
+
+// RefreshOptions configures refreshing several pools
+type RefreshOptions struct {
+	// Concurrency is the number of pools refreshed at the same time, zero means DefaultRefreshConcurrency
+	Concurrency int
+
+	// RatePerSecond is the number of refreshes started per second across all workers, zero means no limit
+	RatePerSecond float64
+
+	// Burst is the number of refreshes which may start at once before the rate limit applies, zero means 1
+	Burst int
+}
+
+// RefreshError is an error of refreshing one pool
+type RefreshError struct {
+	Pool *Pool
+	Err  error
+}
+
+// Error returns the error of the pool
+func (e *RefreshError) Error() string {
+	address, err := e.Pool.Address()
+	if err != nil {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf("failed to refresh pool %s: %s", address, e.Err.Error())
+}
+
+// Unwrap returns the underlying error
+func (e *RefreshError) Unwrap() error {
+	return e.Err
+}
+
+// RefreshResult reports a RefreshAll call
+type RefreshResult struct {
+	// Refreshed is the number of pools refreshed without an error
+	Refreshed int
+
+	// Changed holds pools whose state differs from the state of their previous refresh, in the given order
+	Changed []*Pool
+
+	// Errors holds an error of every pool which failed to refresh, in the given order
+	Errors []*RefreshError
+}
+
+// Err returns nil when every pool was refreshed, otherwise an error wrapping the first pool error
+func (r *RefreshResult) Err() error {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%d of %d pools failed to refresh: %w", len(r.Errors), r.Refreshed+len(r.Errors), r.Errors[0])
+}
+
+// poolState is the part of a pool which a refresh updates
+type poolState struct {
+	liquidityAssetID                uint64
+	asset1Reserves                  uint64
+	asset2Reserves                  uint64
+	issuedLiquidity                 uint64
+	unclaimedProtocolFee            uint64
+	outstandingAsset1Amount         uint64
+	outstandingAsset2Amount         uint64
+	outstandingLiquidityAssetAmount uint64
+	algoBalance                     uint64
+}
+
+// RefreshAll refreshes pools on a pool of workers and reports the pools whose state changed since their LastRefreshedRound.
+// A pool which fails to refresh does not stop the others, its error is collected in the result. The pools have to be distinct.
+func RefreshAll(ctx context.Context, pools []*Pool, opts *RefreshOptions) *RefreshResult {
+	if opts == nil {
+		opts = &RefreshOptions{}
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultRefreshConcurrency
+	}
+	if concurrency > len(pools) {
+		concurrency = len(pools)
+	}
+
+	var limiter *tokenBucket
+	if opts.RatePerSecond > 0 {
+		limiter = newTokenBucket(opts.RatePerSecond, opts.Burst)
+	}
+
+	changed := make([]bool, len(pools))
+	errs := make([]error, len(pools))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for idx := range indexes {
+				changed[idx], errs[idx] = refreshOne(ctx, pools[idx], limiter)
+			}
+		}()
+	}
+
+	for idx := range pools {
+		indexes <- idx
+	}
+	close(indexes)
+	wg.Wait()
+
+	result := &RefreshResult{}
+	for idx, pool := range pools {
+		if errs[idx] != nil {
+			result.Errors = append(result.Errors, &RefreshError{Pool: pool, Err: errs[idx]})
+
+			continue
+		}
+
+		result.Refreshed++
+		if changed[idx] {
+			result.Changed = append(result.Changed, pool)
+		}
+	}
+
+	return result
+}
+
+// refreshOne refreshes a pool once the rate limit allows it and reports whether its state changed
+func refreshOne(ctx context.Context, pool *Pool, limiter *tokenBucket) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	if limiter != nil {
+		if err := limiter.wait(ctx); err != nil {
+			return false, err
+		}
+	}
+
+	before := pool.state()
+	if err := pool.Refresh(ctx, nil); err != nil {
+		return false, err
+	}
+
+	return pool.state() != before, nil
+}
+
+// state returns the part of the pool which a refresh updates
+func (p *Pool) state() poolState {
+	var liquidityAssetID uint64
+	if p.LiquidityAsset != nil {
+		liquidityAssetID = p.LiquidityAsset.ID
+	}
+
+	return poolState{
+		liquidityAssetID:                liquidityAssetID,
+		asset1Reserves:                  p.Asset1Reserves,
+		asset2Reserves:                  p.Asset2Reserves,
+		issuedLiquidity:                 p.IssuedLiquidity,
+		unclaimedProtocolFee:            p.UnclaimedProtocolFee,
+		outstandingAsset1Amount:         p.OutstandingAsset1Amount,
+		outstandingAsset2Amount:         p.OutstandingAsset2Amount,
+		outstandingLiquidityAssetAmount: p.OutstandingLiquidityAssetAmount,
+		algoBalance:                     p.AlgoBalance,
+	}
+}
@@ -0,0 +1,107 @@
+package pools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+)
+
+// Source lists accounts which are opted into a validator app
+type Source interface {
+	// ListAccounts returns a page of accounts opted into the validator app and a token of the next page.
+	// An empty token means there are no more pages.
+	ListAccounts(ctx context.Context, validatorAppID, limit uint64, next string) ([]models.Account, string, error)
+}
+
+// IndexerSource lists accounts by searching an indexer
+type IndexerSource struct {
+	ic types.IndexerAPI
+}
+
+// NewIndexerSource creates a source backed by an indexer
+func NewIndexerSource(ic types.IndexerAPI) *IndexerSource {
+	return &IndexerSource{ic: ic}
+}
+
+// ListAccounts returns a page of accounts opted into the validator app
+func (s *IndexerSource) ListAccounts(ctx context.Context, validatorAppID, limit uint64, next string) ([]models.Account, string, error) {
+	res, err := s.ic.SearchAccountsByApp(ctx, validatorAppID, limit, next)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for idx := range res.Accounts {
+		if res.Accounts[idx].Round == 0 {
+			res.Accounts[idx].Round = res.CurrentRound
+		}
+	}
+
+	// the indexer keeps returning a token until a page comes back empty
+	if len(res.Accounts) == 0 {
+		return nil, "", nil
+	}
+
+	return res.Accounts, res.NextToken, nil
+}
+
+// StaticSource lists accounts from a fixed list of addresses by reading them from algod
+type StaticSource struct {
+	ac        types.AlgodAPI
+	addresses []string
+}
+
+// NewStaticSource creates a source backed by a fixed list of addresses
+func NewStaticSource(ac types.AlgodAPI, addresses []string) *StaticSource {
+	return &StaticSource{
+		ac:        ac,
+		addresses: addresses,
+	}
+}
+
+// ListAccounts returns a page of accounts opted into the validator app, the token is an offset into the address list
+func (s *StaticSource) ListAccounts(ctx context.Context, validatorAppID, limit uint64, next string) ([]models.Account, string, error) {
+	var offset uint64
+	if len(next) > 0 {
+		o, err := strconv.ParseUint(next, 10, 64)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid next token '%s'", next)
+		}
+
+		offset = o
+	}
+
+	total := uint64(len(s.addresses))
+	if offset >= total {
+		return nil, "", nil
+	}
+
+	end := total
+	if limit > 0 && offset+limit < total {
+		end = offset + limit
+	}
+
+	var accounts []models.Account
+	for _, address := range s.addresses[offset:end] {
+		account, err := s.ac.AccountInformation(ctx, address)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, as := range account.AppsLocalState {
+			if as.Id == validatorAppID {
+				accounts = append(accounts, account)
+				break
+			}
+		}
+	}
+
+	if end == total {
+		return accounts, "", nil
+	}
+
+	return accounts, strconv.FormatUint(end, 10), nil
+}
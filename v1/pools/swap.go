@@ -24,7 +24,7 @@ func (p *Pool) PrepareSwapTransactions(
 		swapperAddress = p.UserAddress
 	}
 
-	sp, err := p.ac.SuggestedParams().Do(ctx)
+	sp, err := p.ac.SuggestedParams(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -53,6 +53,10 @@ func (p *Pool) PrepareSwapTransactionsFromQuote(ctx context.Context, quote *type
 	if quote == nil {
 		return nil, fmt.Errorf("quote is required")
 	}
+	amountIn, err := quote.AmountInWithSlippage()
+	if err != nil {
+		return nil, err
+	}
 	amountOut, err := quote.AmountOutWithSlippage()
 	if err != nil {
 		return nil, err
@@ -60,7 +64,7 @@ func (p *Pool) PrepareSwapTransactionsFromQuote(ctx context.Context, quote *type
 
 	txGroup, err := p.PrepareSwapTransactions(
 		ctx,
-		quote.AmountIn,
+		amountIn,
 		amountOut,
 		quote.SwapType,
 		swapperAddress,
@@ -71,3 +75,13 @@ func (p *Pool) PrepareSwapTransactionsFromQuote(ctx context.Context, quote *type
 
 	return txGroup, nil
 }
+
+// PrepareSwapPlanFromQuote prepares swap transactions from a given swap quote, together with the app and asset opt-ins the user is missing
+func (p *Pool) PrepareSwapPlanFromQuote(ctx context.Context, quote *types.SwapQuote, swapperAddress string) (*utils.TransactionPlan, error) {
+	txGroup, err := p.PrepareSwapTransactionsFromQuote(ctx, quote, swapperAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.PrepareOptInPlan(ctx, txGroup, swapperAddress)
+}
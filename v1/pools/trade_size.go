@@ -0,0 +1,80 @@
+package pools
+
+import (
+	"fmt"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/v1/quote"
+)
+
+// MaxAmountInForPriceImpact returns the largest amount of an input asset which can be sold before the price impact exceeds maxImpactBps, which has to be below 10000,
+// it is calculated from the current pool state without refreshing it
+func (p *Pool) MaxAmountInForPriceImpact(assetIn *types.Asset, maxImpactBps uint64) (*types.AssetAmount, error) {
+	inputSupply, outputSupply, err := p.supplies(assetIn)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := quote.MaxInputForPriceImpact(inputSupply, outputSupply, maxImpactBps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AssetAmount{Asset: assetIn, Amount: amount}, nil
+}
+
+// AmountInForPrice returns the smallest amount of an input asset which moves its pool price, in base units of the other asset, down to a target price.
+// It is calculated from the current pool state without refreshing it.
+func (p *Pool) AmountInForPrice(assetIn *types.Asset, price float64) (*types.AssetAmount, error) {
+	inputSupply, outputSupply, err := p.supplies(assetIn)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := quote.InputForPrice(inputSupply, outputSupply, price)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AssetAmount{Asset: assetIn, Amount: amount}, nil
+}
+
+// AmountInForAmountOut returns the smallest input amount of a fixed input swap which gives at least a desired output amount after the swap fee,
+// it is calculated from the current pool state without refreshing it
+func (p *Pool) AmountInForAmountOut(amountOut *types.AssetAmount) (*types.AssetAmount, error) {
+	if amountOut == nil {
+		return nil, fmt.Errorf("amountOut is required")
+	}
+
+	outputSupply, inputSupply, err := p.supplies(amountOut.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	assetIn := p.Asset1
+	if amountOut.Asset.Equal(p.Asset1) {
+		assetIn = p.Asset2
+	}
+
+	amount, err := quote.InputForOutput(inputSupply, outputSupply, amountOut.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AssetAmount{Asset: assetIn, Amount: amount}, nil
+}
+
+// supplies returns reserves of a given asset and of the other asset of the pool
+func (p *Pool) supplies(asset *types.Asset) (uint64, uint64, error) {
+	if asset == nil {
+		return 0, 0, fmt.Errorf("asset is required")
+	}
+
+	if asset.Equal(p.Asset1) {
+		return p.Asset1Reserves, p.Asset2Reserves, nil
+	} else if asset.Equal(p.Asset2) {
+		return p.Asset2Reserves, p.Asset1Reserves, nil
+	}
+
+	return 0, 0, types.ErrAssetMismatch
+}
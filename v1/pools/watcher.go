@@ -0,0 +1,288 @@
+package pools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+	"github.com/synycboom/tinyman-go-sdk/v1/quote"
+)
+
+// EventType is a type of a pool change event
+type EventType string
+
+const (
+	// EventReservesChanged is emitted when the reserves of a pool change
+	EventReservesChanged EventType = "reserves-changed"
+
+	// EventLiquidityIssued is emitted when the issued liquidity of a pool grows
+	EventLiquidityIssued EventType = "liquidity-issued"
+
+	// EventLiquidityBurned is emitted when the issued liquidity of a pool shrinks
+	EventLiquidityBurned EventType = "liquidity-burned"
+
+	// EventProtocolFeeAccrued is emitted when the unclaimed protocol fee of a pool grows
+	EventProtocolFeeAccrued EventType = "protocol-fee-accrued"
+
+	// EventPriceCrossed is emitted when the price of a threshold moves from one side of it to the other
+	EventPriceCrossed EventType = "price-crossed"
+
+	// EventReadFailed is emitted when the node could not be read at a round, the round is retried after RetryInterval
+	EventReadFailed EventType = "read-failed"
+)
+
+// PriceThreshold is a price level of an asset in a pool, the price is in base units of the other asset like Pool.Asset1Price
+type PriceThreshold struct {
+	// PoolAddress is the address of a watched pool
+	PoolAddress string
+
+	// AssetID is the id of the asset whose price is watched
+	AssetID uint64
+
+	// Price is the price level
+	Price float64
+}
+
+// Event is a change of a watched pool between two reads of its state
+type Event struct {
+	// Type is the type of the change
+	Type EventType
+
+	// Round is the round the change was seen at
+	Round uint64
+
+	// PoolAddress is the address of the pool
+	PoolAddress string
+
+	// Before is the pool state at the previous read
+	Before types.PoolInfo
+
+	// After is the pool state at this read
+	After types.PoolInfo
+
+	// Threshold is the crossed threshold of an EventPriceCrossed event
+	Threshold *PriceThreshold
+
+	// PriceBefore and PriceAfter are the prices of the threshold asset of an EventPriceCrossed event
+	PriceBefore float64
+	PriceAfter  float64
+
+	// Err is the read error of an EventReadFailed event
+	Err error
+}
+
+// Watcher follows the node round by round and emits an event for every change of the watched pools.
+// It reads the pool states without updating the pools, use Pool.WithInfo to get a pool of an event state.
+// Pools which use a cache have the cache updated with every read state.
+type Watcher struct {
+	status     types.StatusAPI
+	pools      []*Pool
+	thresholds []PriceThreshold
+	events     chan Event
+
+	// RetryInterval is the time to wait before a failed read is retried
+	RetryInterval time.Duration
+}
+
+// NewWatcher creates a watcher of pools, the algod client has to implement types.StatusAPI.
+// Events are delivered on a channel with a given buffer size, the watcher waits for a full channel to be read.
+func NewWatcher(ac types.AlgodAPI, pools []*Pool, thresholds []PriceThreshold, bufferSize int) (*Watcher, error) {
+	status, ok := statusAPI(ac)
+	if !ok {
+		return nil, fmt.Errorf("algod client does not implement the status API")
+	}
+	if len(pools) == 0 {
+		return nil, fmt.Errorf("at least one pool is required")
+	}
+
+	return &Watcher{
+		status:        status,
+		pools:         pools,
+		thresholds:    thresholds,
+		events:        make(chan Event, bufferSize),
+		RetryInterval: constants.WaitInterval,
+	}, nil
+}
+
+// Events returns the channel of events, it is closed when Run returns
+func (w *Watcher) Events() <-chan Event {
+	return w.events
+}
+
+// Run reads the watched pools at the current round, then re-reads them at every following round and emits their changes.
+// Rounds are visited one by one, a round which a read already went past is skipped since its state can no longer be read.
+// A failed read is reported as an EventReadFailed event and retried, Run only returns the context error when the context is done.
+func (w *Watcher) Run(ctx context.Context) error {
+	defer close(w.events)
+
+	var states []*types.PoolInfo
+	var round uint64
+	for states == nil {
+		status, err := w.status.Status(ctx)
+		if err == nil {
+			round = status.LastRound
+			states, round, err = w.read(ctx, round)
+		}
+		if err != nil {
+			if err := w.retry(ctx, round, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		if _, err := w.status.StatusAfterBlock(ctx, round); err != nil {
+			if err := w.retry(ctx, round+1, err); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		next, readRound, err := w.read(ctx, round+1)
+		if err != nil {
+			if err := w.retry(ctx, round+1, err); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		for idx := range w.pools {
+			before, after := states[idx], next[idx]
+			if before == nil || after == nil {
+				continue
+			}
+
+			for _, event := range w.changes(before, after, readRound) {
+				if err := w.emit(ctx, event); err != nil {
+					return err
+				}
+			}
+		}
+
+		states, round = next, readRound
+	}
+}
+
+// retry reports a failed read of a round and waits for RetryInterval, it returns the context error when the context is done
+func (w *Watcher) retry(ctx context.Context, round uint64, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err := w.emit(ctx, Event{Type: EventReadFailed, Round: round, Err: err}); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(w.RetryInterval):
+		return nil
+	}
+}
+
+// emit delivers an event, it waits for a full channel to be read until the context is done
+func (w *Watcher) emit(ctx context.Context, event Event) error {
+	select {
+	case w.events <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// read reads states of the watched pools at a round and returns the round they were read at,
+// which is later than the given round when the node has moved on. The state of a pool which does not exist is nil.
+func (w *Watcher) read(ctx context.Context, round uint64) ([]*types.PoolInfo, uint64, error) {
+	states := make([]*types.PoolInfo, len(w.pools))
+	readRound := round
+	for idx, pool := range w.pools {
+		info, err := PoolInfo(ctx, pool.ac, pool.ValidatorAppID, pool.Asset1.ID, pool.Asset2.ID)
+		if err != nil {
+			return nil, 0, err
+		}
+		if info == nil {
+			continue
+		}
+
+		if info.Round > readRound {
+			readRound = info.Round
+		}
+		if pool.cache != nil {
+			pool.cache.Advance(info.Round)
+			pool.cache.Put(info)
+		}
+		states[idx] = info
+	}
+
+	return states, readRound, nil
+}
+
+// changes returns events of a pool between two states
+func (w *Watcher) changes(before, after *types.PoolInfo, round uint64) []Event {
+	newEvent := func(eventType EventType) Event {
+		return Event{
+			Type:        eventType,
+			Round:       round,
+			PoolAddress: after.Address,
+			Before:      *before,
+			After:       *after,
+		}
+	}
+
+	var events []Event
+	if before.Asset1Reserves != after.Asset1Reserves || before.Asset2Reserves != after.Asset2Reserves || before.AlgoBalance != after.AlgoBalance {
+		events = append(events, newEvent(EventReservesChanged))
+	}
+	if after.IssuedLiquidity > before.IssuedLiquidity {
+		events = append(events, newEvent(EventLiquidityIssued))
+	} else if after.IssuedLiquidity < before.IssuedLiquidity {
+		events = append(events, newEvent(EventLiquidityBurned))
+	}
+	if after.UnclaimedProtocolFee > before.UnclaimedProtocolFee {
+		events = append(events, newEvent(EventProtocolFeeAccrued))
+	}
+
+	for idx := range w.thresholds {
+		threshold := &w.thresholds[idx]
+		if threshold.PoolAddress != after.Address {
+			continue
+		}
+
+		priceBefore, ok := thresholdPrice(before, threshold.AssetID)
+		if !ok {
+			continue
+		}
+		priceAfter, ok := thresholdPrice(after, threshold.AssetID)
+		if !ok {
+			continue
+		}
+
+		if (priceBefore < threshold.Price) != (priceAfter < threshold.Price) {
+			event := newEvent(EventPriceCrossed)
+			event.Threshold = threshold
+			event.PriceBefore = priceBefore
+			event.PriceAfter = priceAfter
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+// thresholdPrice returns the price of an asset of a pool state, it is false when the pool has no reserves
+func thresholdPrice(info *types.PoolInfo, assetID uint64) (float64, bool) {
+	asset1Reserves, asset2Reserves := quote.Reserves(info)
+	if asset1Reserves == 0 || asset2Reserves == 0 {
+		return 0, false
+	}
+
+	if assetID == info.Asset1ID {
+		return float64(asset2Reserves) / float64(asset1Reserves), true
+	}
+
+	return float64(asset1Reserves) / float64(asset2Reserves), true
+}
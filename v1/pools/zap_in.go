@@ -0,0 +1,185 @@
+package pools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+	"github.com/synycboom/tinyman-go-sdk/v1/contracts"
+	"github.com/synycboom/tinyman-go-sdk/v1/quote"
+)
+
+// FetchZapInQuote refreshes the pool and returns a zap-in quote, a pool using a cache is only refreshed when its cached state is stale
+func (p *Pool) FetchZapInQuote(ctx context.Context, amountIn *types.AssetAmount, slippageBps uint64) (*types.ZapInQuote, error) {
+	if err := p.RefreshIfStale(ctx); err != nil {
+		return nil, err
+	}
+
+	return p.ZapInQuote(amountIn, slippageBps)
+}
+
+// ZapInQuote returns a quote which provides liquidity from a single asset amount from the current pool state without refreshing it.
+// It swaps the part of the input which balances the rest of it with the minimum output of the swap, so the mint gives the most liquidity.
+func (p *Pool) ZapInQuote(amountIn *types.AssetAmount, slippageBps uint64) (*types.ZapInQuote, error) {
+	if amountIn == nil {
+		return nil, fmt.Errorf("amountIn is required")
+	}
+	if !p.exists {
+		return nil, types.ErrPoolNotBootstrapped
+	}
+
+	inputSupply, outputSupply, err := p.supplies(amountIn.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	swapAmount, err := quote.ZapSwapAmount(inputSupply, outputSupply, p.IssuedLiquidity, amountIn.Amount, slippageBps)
+	if err != nil {
+		return nil, err
+	}
+
+	swapQuote, err := p.FixedInputSwapQuote(&types.AssetAmount{Asset: amountIn.Asset, Amount: swapAmount}, slippageBps)
+	if err != nil {
+		return nil, err
+	}
+
+	// the swap issues liquidity as the protocol fee, so the mint shares the reserves with more liquidity
+	post := *p
+	if version, _ := contracts.Version(p.ValidatorAppID); version == constants.Version1_1 {
+		post.IssuedLiquidity += quote.ProtocolFee(inputSupply, p.IssuedLiquidity, swapQuote.AmountIn.Amount)
+	}
+	if amountIn.Asset.Equal(p.Asset1) {
+		post.Asset1Reserves += swapQuote.AmountIn.Amount
+		post.Asset2Reserves -= swapQuote.AmountOut.Amount
+	} else {
+		post.Asset2Reserves += swapQuote.AmountIn.Amount
+		post.Asset1Reserves -= swapQuote.AmountOut.Amount
+	}
+
+	rest := &types.AssetAmount{Asset: amountIn.Asset, Amount: amountIn.Amount - swapAmount}
+	mintQuote, err := post.MintQuote(rest, swapQuote.MinAmountOut, slippageBps)
+	if err != nil {
+		return nil, err
+	}
+
+	excess := make(map[uint64]types.AssetAmount)
+	addExcess(excess, swapQuote.AmountOut.Asset, swapQuote.AmountOut.Amount-swapQuote.MinAmountOut.Amount)
+	addExcess(excess, p.LiquidityAsset, mintQuote.LiquidityAssetAmount.Amount-mintQuote.MinLiquidityAssetAmount.Amount)
+
+	return &types.ZapInQuote{
+		AmountIn:                *amountIn,
+		SwapQuote:               swapQuote,
+		MintQuote:               mintQuote,
+		LiquidityAssetAmount:    mintQuote.LiquidityAssetAmount,
+		MinLiquidityAssetAmount: *mintQuote.MinLiquidityAssetAmount,
+		Excess:                  excess,
+	}, nil
+}
+
+// PrepareZapInPlanFromQuote prepares a plan from a zap-in quote which swaps, mints and redeems the excess amounts of the user in this pool.
+// The missing app and asset opt-ins are submitted first as a pre-flight group. The redeems are prepared from the excess amounts read
+// after the mint is confirmed, so they match what the swap and the mint actually left.
+func (p *Pool) PrepareZapInPlanFromQuote(ctx context.Context, zapQuote *types.ZapInQuote, userAddress string) (*utils.TransactionPlan, error) {
+	if zapQuote == nil {
+		return nil, fmt.Errorf("quote is required")
+	}
+	if len(userAddress) == 0 {
+		userAddress = p.UserAddress
+	}
+
+	swapGroup, err := p.PrepareSwapTransactionsFromQuote(ctx, zapQuote.SwapQuote, userAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	mintGroup, err := p.PrepareMintTransactionsFromQuote(ctx, zapQuote.MintQuote, userAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	redeemAfter := func(idx int) []*types.Asset {
+		if idx == 1 {
+			return []*types.Asset{p.Asset1, p.Asset2, p.LiquidityAsset}
+		}
+
+		return nil
+	}
+
+	return p.prepareExcessPlan(ctx, userAddress, redeemAfter, swapGroup, mintGroup)
+}
+
+// prepareExcessPlan prepares a plan of given transaction groups preceded by the missing opt-ins of the user.
+// redeemAfter orders the redeems, it returns the assets whose excess amounts of the user in this pool are redeemed after the group at an index.
+// Each redeem step reads the excess amounts once the group before it is confirmed, since a redeem has to match the stored amount.
+func (p *Pool) prepareExcessPlan(
+	ctx context.Context,
+	userAddress string,
+	redeemAfter func(idx int) []*types.Asset,
+	txGroups ...*utils.TransactionGroup,
+) (*utils.TransactionPlan, error) {
+	optIns, err := p.missingOptIns(ctx, userAddress, txGroups...)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := utils.NewTransactionPlan()
+	if len(optIns) > 0 {
+		preflight, err := utils.NewTransactionGroup(optIns)
+		if err != nil {
+			return nil, err
+		}
+
+		plan.Add(preflight)
+	}
+
+	for idx, txGroup := range txGroups {
+		plan.Add(txGroup)
+		if assets := redeemAfter(idx); len(assets) > 0 {
+			plan.AddStep(p.redeemStep(userAddress, assets))
+		}
+	}
+
+	return plan, nil
+}
+
+// redeemStep returns a plan step which redeems the current excess amounts of the user in this pool of given assets
+func (p *Pool) redeemStep(userAddress string, assets []*types.Asset) utils.PlanStep {
+	return func(ctx context.Context) ([]*utils.TransactionGroup, error) {
+		quotes, err := p.FetchExcessAmounts(ctx, userAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		var redeems []*utils.TransactionGroup
+		for _, asset := range assets {
+			for idx := range quotes {
+				if quotes[idx].Amount.Asset.ID != asset.ID || quotes[idx].Amount.Amount == 0 {
+					continue
+				}
+
+				redeemGroup, err := p.PrepareRedeemTransactionsFromQuote(ctx, &quotes[idx], userAddress)
+				if err != nil {
+					return nil, err
+				}
+
+				redeems = append(redeems, redeemGroup)
+			}
+		}
+
+		return redeems, nil
+	}
+}
+
+// addExcess adds a non-zero excess amount of an asset to an asset mapping
+func addExcess(excess map[uint64]types.AssetAmount, asset *types.Asset, amount uint64) {
+	if amount == 0 {
+		return
+	}
+
+	total := excess[asset.ID]
+	total.Asset = asset
+	total.Amount += amount
+	excess[asset.ID] = total
+}
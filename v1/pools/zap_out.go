@@ -0,0 +1,169 @@
+package pools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+)
+
+// FetchZapOutQuote refreshes the pool and returns a zap-out quote, a pool using a cache is only refreshed when its cached state is stale
+func (p *Pool) FetchZapOutQuote(ctx context.Context, liquidityAsset *types.AssetAmount, assetOut *types.Asset, slippageBps uint64) (*types.ZapOutQuote, error) {
+	if err := p.RefreshIfStale(ctx); err != nil {
+		return nil, err
+	}
+
+	return p.ZapOutQuote(liquidityAsset, assetOut, slippageBps)
+}
+
+// ZapOutQuote returns a quote which burns a liquidity asset amount into a single asset from the current pool state without refreshing it.
+// The other asset received by the burn is swapped for the chosen one against the reserves after the burn, the plan of the quote
+// sells the amount the burn actually pays out, so the swap quote is an estimate of it.
+func (p *Pool) ZapOutQuote(liquidityAsset *types.AssetAmount, assetOut *types.Asset, slippageBps uint64) (*types.ZapOutQuote, error) {
+	if liquidityAsset == nil || assetOut == nil {
+		return nil, fmt.Errorf("liquidityAsset and assetOut are required")
+	}
+
+	assetIn := p.Asset1
+	if assetOut.Equal(p.Asset1) {
+		assetIn = p.Asset2
+	} else if !assetOut.Equal(p.Asset2) {
+		return nil, types.ErrAssetMismatch
+	}
+
+	burnQuote, err := p.BurnQuote(liquidityAsset, slippageBps)
+	if err != nil {
+		return nil, err
+	}
+
+	post := *p
+	post.Asset1Reserves -= burnQuote.AmountsOut[p.Asset1.ID].Amount
+	post.Asset2Reserves -= burnQuote.AmountsOut[p.Asset2.ID].Amount
+	post.IssuedLiquidity -= liquidityAsset.Amount
+
+	swapQuote, err := post.FixedInputSwapQuote(&types.AssetAmount{Asset: assetIn, Amount: burnQuote.AmountsOut[assetIn.ID].Amount}, slippageBps)
+	if err != nil {
+		return nil, err
+	}
+
+	excess := make(map[uint64]types.AssetAmount)
+	for assetID, amount := range burnQuote.AmountsOut {
+		addExcess(excess, amount.Asset, amount.Amount-burnQuote.MinAmountsOut[assetID].Amount)
+	}
+	addExcess(excess, assetOut, swapQuote.AmountOut.Amount-swapQuote.MinAmountOut.Amount)
+
+	return &types.ZapOutQuote{
+		LiquidityAssetAmount: *liquidityAsset,
+		BurnQuote:            burnQuote,
+		SwapQuote:            swapQuote,
+		AmountOut: types.AssetAmount{
+			Asset:  assetOut,
+			Amount: burnQuote.AmountsOut[assetOut.ID].Amount + swapQuote.AmountOut.Amount,
+		},
+		MinAmountOut: types.AssetAmount{
+			Asset:  assetOut,
+			Amount: burnQuote.MinAmountsOut[assetOut.ID].Amount + swapQuote.MinAmountOut.Amount,
+		},
+		Excess: excess,
+	}, nil
+}
+
+// PrepareZapOutPlanFromQuote prepares a plan from a zap-out quote which leaves the user holding only the chosen asset out of the burned liquidity.
+// The plan holds the missing opt-ins, the burn group, a redeem of the other asset with the swap and a redeem of the chosen asset.
+// The burn only guarantees its minimum amounts, so the swap is prepared once the burn is confirmed and sells the minimum amount
+// of the other asset plus the excess amount the burn added, against the pool state after the burn.
+// Each redeem is prepared from the excess amounts read after the group before it is confirmed, which include the amounts the user already had in the pool.
+func (p *Pool) PrepareZapOutPlanFromQuote(ctx context.Context, zapQuote *types.ZapOutQuote, userAddress string) (*utils.TransactionPlan, error) {
+	if zapQuote == nil {
+		return nil, fmt.Errorf("quote is required")
+	}
+	if len(userAddress) == 0 {
+		userAddress = p.UserAddress
+	}
+
+	burnGroup, err := p.PrepareBurnTransactionsFromQuote(ctx, zapQuote.BurnQuote, userAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// the excess amount the user already had is redeemed but not sold
+	assetIn := zapQuote.SwapQuote.AmountIn.Asset
+	excessBefore, err := p.excessAmount(ctx, userAddress, assetIn)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := p.prepareExcessPlan(ctx, userAddress, func(int) []*types.Asset { return nil }, burnGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	plan.AddStep(p.zapOutSwapStep(userAddress, zapQuote, excessBefore))
+	plan.AddStep(p.redeemStep(userAddress, []*types.Asset{zapQuote.AmountOut.Asset}))
+
+	return plan, nil
+}
+
+// zapOutSwapStep returns a plan step which redeems the excess amount of the other asset of a zap-out
+// and swaps the amount the burn paid out of it for the chosen asset
+func (p *Pool) zapOutSwapStep(userAddress string, zapQuote *types.ZapOutQuote, excessBefore uint64) utils.PlanStep {
+	return func(ctx context.Context) ([]*utils.TransactionGroup, error) {
+		assetIn := zapQuote.SwapQuote.AmountIn.Asset
+		quotes, err := p.FetchExcessAmounts(ctx, userAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		amountIn := zapQuote.BurnQuote.MinAmountsOut[assetIn.ID].Amount
+		var groups []*utils.TransactionGroup
+		for idx := range quotes {
+			if quotes[idx].Amount.Asset.ID != assetIn.ID || quotes[idx].Amount.Amount == 0 {
+				continue
+			}
+
+			redeemGroup, err := p.PrepareRedeemTransactionsFromQuote(ctx, &quotes[idx], userAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			groups = append(groups, redeemGroup)
+			if quotes[idx].Amount.Amount > excessBefore {
+				amountIn += quotes[idx].Amount.Amount - excessBefore
+			}
+		}
+
+		if err := p.Refresh(ctx, nil); err != nil {
+			return nil, err
+		}
+
+		swapQuote, err := p.FixedInputSwapQuote(&types.AssetAmount{Asset: assetIn, Amount: amountIn}, zapQuote.SwapQuote.SlippageBps)
+		if err != nil {
+			return nil, err
+		}
+
+		swapGroup, err := p.PrepareSwapTransactionsFromQuote(ctx, swapQuote, userAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(groups, swapGroup), nil
+	}
+}
+
+// excessAmount returns the current excess amount of the user in this pool of an asset
+func (p *Pool) excessAmount(ctx context.Context, userAddress string, asset *types.Asset) (uint64, error) {
+	quotes, err := p.FetchExcessAmounts(ctx, userAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	var amount uint64
+	for idx := range quotes {
+		if quotes[idx].Amount.Asset.ID == asset.ID {
+			amount += quotes[idx].Amount.Amount
+		}
+	}
+
+	return amount, nil
+}
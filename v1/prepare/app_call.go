@@ -0,0 +1,44 @@
+package prepare
+
+import (
+	"github.com/algorand/go-algorand-sdk/future"
+	"github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/synycboom/tinyman-go-sdk/v1/contracts"
+)
+
+// poolCallTx makes a validator app call of a pool operation, every contracts version reads the user local state from
+// the first account and the pool assets from foreign assets
+func poolCallTx(
+	validatorAppID,
+	asset1ID,
+	asset2ID,
+	liquidityAssetID uint64,
+	appArgs [][]byte,
+	senderAddress string,
+	poolAddress types.Address,
+	sp types.SuggestedParams,
+) (types.Transaction, error) {
+	if _, err := contracts.Version(validatorAppID); err != nil {
+		return types.Transaction{}, err
+	}
+
+	foreignAssets := []uint64{asset1ID, asset2ID, liquidityAssetID}
+	if asset2ID == 0 {
+		foreignAssets = []uint64{asset1ID, liquidityAssetID}
+	}
+
+	return future.MakeApplicationNoOpTx(
+		validatorAppID,
+		appArgs,
+		[]string{senderAddress},
+		nil,
+		foreignAssets,
+		sp,
+		poolAddress,
+		nil,
+		types.Digest{},
+		[32]byte{},
+		types.Address{},
+	)
+}
@@ -94,8 +94,8 @@ func BootstrapTransactions(
 		"",
 		"",
 		"",
-		constants.LiquidityAssetUnitName,
-		fmt.Sprintf("TinymanPool1.1 %s-%s", asset1UnitName, asset2UnitName),
+		contracts.LiquidityAssetUnitName(validatorAppID),
+		contracts.LiquidityAssetName(validatorAppID, asset1UnitName, asset2UnitName),
 		constants.TinyManURL,
 		"",
 	)
@@ -1,6 +1,8 @@
 package prepare
 
 import (
+	"fmt"
+
 	"github.com/algorand/go-algorand-sdk/future"
 	"github.com/algorand/go-algorand-sdk/types"
 
@@ -38,3 +40,28 @@ func AppOptOutTransactions(validatorAppID uint64, senderAddress string, sp types
 
 	return txGroup, nil
 }
+
+// AssetCloseTransactions prepares a transaction group to close holdings of assets, each one to the address at the same index,
+// e.g. the creator of the asset which always accepts it. A remaining balance of a holding is sent to that address as well.
+func AssetCloseTransactions(assetIDs []uint64, closeToAddresses []string, senderAddress string, sp types.SuggestedParams) (*utils.TransactionGroup, error) {
+	if len(assetIDs) != len(closeToAddresses) {
+		return nil, fmt.Errorf("expected %d close-to addresses, got %d", len(assetIDs), len(closeToAddresses))
+	}
+
+	txs := make([]types.Transaction, len(assetIDs))
+	for idx, assetID := range assetIDs {
+		tx, err := future.MakeAssetTransferTxn(senderAddress, closeToAddresses[idx], 0, nil, sp, closeToAddresses[idx], assetID)
+		if err != nil {
+			return nil, err
+		}
+
+		txs[idx] = tx
+	}
+
+	txGroup, err := utils.NewTransactionGroup(txs)
+	if err != nil {
+		return nil, err
+	}
+
+	return txGroup, nil
+}
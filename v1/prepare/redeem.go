@@ -40,24 +40,7 @@ func RedeemTransactions(
 		return nil, err
 	}
 
-	foreignAssets := []uint64{asset1ID, asset2ID, liquidityAssetID}
-	if asset2ID == 0 {
-		foreignAssets = []uint64{asset1ID, liquidityAssetID}
-	}
-
-	tx2, err = future.MakeApplicationNoOpTx(
-		validatorAppID,
-		[][]byte{[]byte("redeem")},
-		[]string{senderAddress},
-		nil,
-		foreignAssets,
-		sp,
-		poolAddress,
-		nil,
-		types.Digest{},
-		[32]byte{},
-		types.Address{},
-	)
+	tx2, err = poolCallTx(validatorAppID, asset1ID, asset2ID, liquidityAssetID, [][]byte{[]byte("redeem")}, senderAddress, poolAddress, sp)
 	if err != nil {
 		return nil, err
 	}
@@ -48,27 +48,8 @@ func SwapTransactions(
 		return nil, err
 	}
 
-	appIdx := validatorAppID
 	appArgs := [][]byte{[]byte("swap"), []byte(constants.SwapTypeMapping[swapType])}
-	accounts := []string{senderAddress}
-	foreignAssets := []uint64{asset1ID, asset2ID, liquidityAssetID}
-	if asset2ID == 0 {
-		foreignAssets = []uint64{asset1ID, liquidityAssetID}
-	}
-
-	tx2, err = future.MakeApplicationNoOpTx(
-		appIdx,
-		appArgs,
-		accounts,
-		nil,
-		foreignAssets,
-		sp,
-		poolAddress,
-		nil,
-		types.Digest{},
-		[32]byte{},
-		types.Address{},
-	)
+	tx2, err = poolCallTx(validatorAppID, asset1ID, asset2ID, liquidityAssetID, appArgs, senderAddress, poolAddress, sp)
 	if err != nil {
 		return nil, err
 	}
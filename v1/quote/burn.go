@@ -0,0 +1,68 @@
+package quote
+
+import (
+	"fmt"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+)
+
+// BurnAmounts returns asset1 and asset2 amounts received for burning a liquidity asset amount
+func BurnAmounts(asset1Reserves, asset2Reserves, issuedLiquidity, liquidity uint64) (uint64, uint64, error) {
+	if issuedLiquidity == 0 {
+		return 0, 0, fmt.Errorf("pool has no issued liquidity: %w", types.ErrInsufficientLiquidity)
+	}
+	if liquidity > issuedLiquidity {
+		return 0, 0, fmt.Errorf("liquidity asset amount %d: %w", liquidity, types.ErrInsufficientLiquidity)
+	}
+
+	amount1 := utils.BigIntDiv(
+		utils.BigIntMul(utils.ToBigUint(liquidity), utils.ToBigUint(asset1Reserves)),
+		utils.ToBigUint(issuedLiquidity),
+	)
+	amount2 := utils.BigIntDiv(
+		utils.BigIntMul(utils.ToBigUint(liquidity), utils.ToBigUint(asset2Reserves)),
+		utils.ToBigUint(issuedLiquidity),
+	)
+
+	return amount1.Uint64(), amount2.Uint64(), nil
+}
+
+// Burn returns a burn quote against a pool snapshot
+func Burn(info *types.PoolInfo, liquidityAsset *types.AssetAmount, slippageBps uint64) (*types.BurnQuote, error) {
+	if info == nil {
+		return nil, fmt.Errorf("pool info is required")
+	}
+	if liquidityAsset == nil {
+		return nil, fmt.Errorf("liquidityAsset is required")
+	}
+	if liquidityAsset.Asset.ID != info.LiquidityAssetID {
+		return nil, fmt.Errorf("the liquidity asset is not the same as one in a pool: %w", types.ErrAssetMismatch)
+	}
+
+	asset1Reserves, asset2Reserves := Reserves(info)
+	amount1, amount2, err := BurnAmounts(asset1Reserves, asset2Reserves, info.IssuedLiquidity, liquidityAsset.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &types.BurnQuote{
+		AmountsOut: map[uint64]types.AssetAmount{
+			info.Asset1ID: {
+				Asset:  asset(info, info.Asset1ID),
+				Amount: amount1,
+			},
+			info.Asset2ID: {
+				Asset:  asset(info, info.Asset2ID),
+				Amount: amount2,
+			},
+		},
+		LiquidityAssetAmount: *liquidityAsset,
+		SlippageBps:          slippageBps,
+	}
+	if err := quote.SetBounds(); err != nil {
+		return nil, err
+	}
+
+	return quote, nil
+}
@@ -0,0 +1,104 @@
+package quote
+
+import (
+	"fmt"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+)
+
+// MintLiquidity returns a liquidity asset amount minted for given asset amounts, the first mint of a pool without issued liquidity locks 1000 units.
+// The validator app adds both amounts to the reserves in full, the part which exceeds the pool ratio is not returned as excess.
+func MintLiquidity(asset1Reserves, asset2Reserves, issuedLiquidity, amount1, amount2 uint64) (uint64, error) {
+	if issuedLiquidity == 0 {
+		liquidity := utils.BigIntSqrt(utils.BigIntMul(utils.ToBigUint(amount1), utils.ToBigUint(amount2))).Uint64()
+		if liquidity <= 1000 {
+			return 0, fmt.Errorf("amounts of the first mint are too small: %w", types.ErrInsufficientLiquidity)
+		}
+
+		return liquidity - 1000, nil
+	}
+	if asset1Reserves == 0 || asset2Reserves == 0 {
+		return 0, fmt.Errorf("pool has no liquidity: %w", types.ErrInsufficientLiquidity)
+	}
+
+	liquidity1 := utils.BigIntDiv(
+		utils.BigIntMul(utils.ToBigUint(amount1), utils.ToBigUint(issuedLiquidity)),
+		utils.ToBigUint(asset1Reserves),
+	).Uint64()
+	liquidity2 := utils.BigIntDiv(
+		utils.BigIntMul(utils.ToBigUint(amount2), utils.ToBigUint(issuedLiquidity)),
+		utils.ToBigUint(asset2Reserves),
+	).Uint64()
+	if liquidity2 < liquidity1 {
+		return liquidity2, nil
+	}
+
+	return liquidity1, nil
+}
+
+// Mint returns a mint quote against a pool snapshot, amountB may be nil after the first mint and is then matched to the pool ratio
+func Mint(info *types.PoolInfo, amountA, amountB *types.AssetAmount, slippageBps uint64) (*types.MintQuote, error) {
+	if amountA == nil {
+		return nil, fmt.Errorf("amountA is required")
+	}
+
+	otherID, inputSupply, outputSupply, err := supplies(info, amountA.Asset.ID)
+	if err != nil {
+		return nil, err
+	}
+	if info.LiquidityAssetID == 0 {
+		return nil, types.ErrPoolNotBootstrapped
+	}
+	if amountB != nil && amountB.Asset.ID != otherID {
+		return nil, types.ErrAssetMismatch
+	}
+
+	if amountB == nil {
+		if info.IssuedLiquidity == 0 {
+			return nil, fmt.Errorf("amounts required for both assets for first mint")
+		}
+		if inputSupply == 0 {
+			return nil, fmt.Errorf("pool has no liquidity: %w", types.ErrInsufficientLiquidity)
+		}
+
+		amountB = &types.AssetAmount{
+			Asset: asset(info, otherID),
+			Amount: utils.BigIntDiv(
+				utils.BigIntMul(utils.ToBigUint(amountA.Amount), utils.ToBigUint(outputSupply)),
+				utils.ToBigUint(inputSupply),
+			).Uint64(),
+		}
+	}
+
+	amount1, amount2 := amountA, amountB
+	if amountA.Asset.ID != info.Asset1ID {
+		amount1, amount2 = amountB, amountA
+	}
+
+	asset1Reserves, asset2Reserves := Reserves(info)
+	liquidity, err := MintLiquidity(asset1Reserves, asset2Reserves, info.IssuedLiquidity, amount1.Amount, amount2.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if info.IssuedLiquidity == 0 {
+		slippageBps = 0
+	}
+
+	quote := &types.MintQuote{
+		AmountsIn: map[uint64]types.AssetAmount{
+			info.Asset1ID: *amount1,
+			info.Asset2ID: *amount2,
+		},
+		LiquidityAssetAmount: types.AssetAmount{
+			Asset:  asset(info, info.LiquidityAssetID),
+			Amount: liquidity,
+		},
+		SlippageBps: slippageBps,
+	}
+	if err := quote.SetBounds(); err != nil {
+		return nil, err
+	}
+
+	return quote, nil
+}
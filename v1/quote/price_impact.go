@@ -0,0 +1,83 @@
+package quote
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+)
+
+// PriceImpactBps returns the price impact of a swap in basis points, it compares the execution price including the swap fee with the spot price
+func PriceImpactBps(inputSupply, outputSupply, amountIn, amountOut uint64) float64 {
+	if inputSupply == 0 || outputSupply == 0 || amountIn == 0 {
+		return 0
+	}
+
+	// 1 - (amountOut / amountIn) / (outputSupply / inputSupply)
+	executed := new(big.Float).Mul(new(big.Float).SetUint64(amountOut), new(big.Float).SetUint64(inputSupply))
+	spot := new(big.Float).Mul(new(big.Float).SetUint64(amountIn), new(big.Float).SetUint64(outputSupply))
+	ratio, _ := new(big.Float).Quo(executed, spot).Float64()
+
+	return (1 - ratio) * 10000
+}
+
+// PriceImpact returns the price impact in basis points of a fixed input swap against a pool snapshot
+func PriceImpact(info *types.PoolInfo, amountIn *types.AssetAmount) (float64, error) {
+	if amountIn == nil {
+		return 0, fmt.Errorf("amountIn is required")
+	}
+
+	_, inputSupply, outputSupply, err := supplies(info, amountIn.Asset.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	amountOut, _, err := SwapOut(inputSupply, outputSupply, amountIn.Amount)
+	if err != nil {
+		return 0, err
+	}
+
+	return PriceImpactBps(inputSupply, outputSupply, amountIn.Amount, amountOut), nil
+}
+
+// SetExecution sets the spot price, the execution price, the price impact, the reserves after the swap
+// and the swap fee in the output asset of a swap quote against given supplies.
+// The reserves after the swap follow the validator: the input reserve grows by AmountIn, which is the required input of
+// a fixed output swap rather than its slippage buffered MaxAmountIn since the buffer is returned as excess,
+// and the protocol fee is minted as liquidity instead of being taken out of the reserves.
+func SetExecution(swapQuote *types.SwapQuote, inputSupply, outputSupply uint64) {
+	amountIn := swapQuote.AmountIn.Amount
+	amountOut := swapQuote.AmountOut.Amount
+	if inputSupply > 0 {
+		swapQuote.SpotPrice = float64(outputSupply) / float64(inputSupply)
+	}
+	if amountIn > 0 {
+		swapQuote.ExecutionPrice = float64(amountOut) / float64(amountIn)
+	}
+
+	swapQuote.PriceImpactBps = PriceImpactBps(inputSupply, outputSupply, amountIn, amountOut)
+	swapQuote.ReservesAfter = map[uint64]types.AssetAmount{
+		swapQuote.AmountIn.Asset.ID: {
+			Asset:  swapQuote.AmountIn.Asset,
+			Amount: inputSupply + amountIn,
+		},
+		swapQuote.AmountOut.Asset.ID: {
+			Asset:  swapQuote.AmountOut.Asset,
+			Amount: outputSupply - amountOut,
+		},
+	}
+
+	var swapFeeOut uint64
+	if swapQuote.SwapFee != nil && inputSupply > 0 {
+		swapFeeOut = utils.BigIntDiv(
+			utils.BigIntMul(utils.ToBigUint(swapQuote.SwapFee.Amount), utils.ToBigUint(outputSupply)),
+			utils.ToBigUint(inputSupply),
+		).Uint64()
+	}
+
+	swapQuote.SwapFeeOut = &types.AssetAmount{
+		Asset:  swapQuote.AmountOut.Asset,
+		Amount: swapFeeOut,
+	}
+}
@@ -0,0 +1,75 @@
+// Package quote calculates Tinyman quotes from pool snapshots without network access
+package quote
+
+import (
+	"fmt"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+	"github.com/synycboom/tinyman-go-sdk/v1/contracts"
+)
+
+// MinimumBalance returns the minimum balance of a pool account for a given asset2 id
+func MinimumBalance(asset2ID uint64) uint64 {
+	numAssets := 3
+	if asset2ID == 0 {
+		numAssets = 2
+	}
+
+	numCreatedApps := 0
+	numLocalApps := 1
+	totalUints := 16
+	totalByteSlices := 0
+
+	return uint64(constants.MinBalancePerAccount +
+		(constants.MinBalancePerAsset * numAssets) +
+		(constants.MinBalancePerApp * (numCreatedApps + numLocalApps)) +
+		(constants.MinBalancePerAppUint * totalUints) +
+		(constants.MinBalancePerAppByteSlice * totalByteSlices))
+}
+
+// Reserves returns asset1 and asset2 reserves of a pool snapshot, ALGO reserves are derived from the pool balance
+func Reserves(info *types.PoolInfo) (uint64, uint64) {
+	asset2Reserves := info.Asset2Reserves
+	if info.Asset2ID == 0 {
+		asset2Reserves = (info.AlgoBalance - MinimumBalance(info.Asset2ID)) - info.OutstandingAsset2Amount
+	}
+
+	return info.Asset1Reserves, asset2Reserves
+}
+
+// asset returns an asset of a pool snapshot, only details known by the snapshot are set
+func asset(info *types.PoolInfo, assetID uint64) *types.Asset {
+	switch {
+	case assetID == 0:
+		return types.NewAsset(0, constants.AlgoTokenDecimals, constants.AlgoTokenName, constants.AlgoTokenUnitName)
+	case assetID == info.Asset1ID:
+		return &types.Asset{ID: assetID, UnitName: info.Asset1UnitName}
+	case assetID == info.Asset2ID:
+		return &types.Asset{ID: assetID, UnitName: info.Asset2UnitName}
+	}
+
+	return &types.Asset{
+		ID:       info.LiquidityAssetID,
+		Decimals: 6,
+		Name:     info.LiquidityAssetName,
+		UnitName: contracts.LiquidityAssetUnitName(info.ValidatorAppID),
+	}
+}
+
+// supplies returns an other asset id, input and output supplies of a pool snapshot for a given input asset
+func supplies(info *types.PoolInfo, assetID uint64) (uint64, uint64, uint64, error) {
+	if info == nil {
+		return 0, 0, 0, fmt.Errorf("pool info is required")
+	}
+
+	asset1Reserves, asset2Reserves := Reserves(info)
+	switch assetID {
+	case info.Asset1ID:
+		return info.Asset2ID, asset1Reserves, asset2Reserves, nil
+	case info.Asset2ID:
+		return info.Asset1ID, asset2Reserves, asset1Reserves, nil
+	}
+
+	return 0, 0, 0, types.ErrAssetMismatch
+}
@@ -0,0 +1,183 @@
+package quote_test
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+	"github.com/synycboom/tinyman-go-sdk/v1/quote"
+)
+
+const (
+	usdcID           = uint64(10458941)
+	liquidityAssetID = uint64(62368708)
+)
+
+var (
+	usdc = &types.Asset{ID: usdcID}
+	algo = &types.Asset{ID: 0}
+)
+
+func poolInfo() *types.PoolInfo {
+	return &types.PoolInfo{
+		Asset1ID:         usdcID,
+		Asset2ID:         0,
+		LiquidityAssetID: liquidityAssetID,
+		Asset1Reserves:   2000000000,
+		IssuedLiquidity:  1000000000,
+		ValidatorAppID:   constants.TestnetValidatorAppId,
+		AlgoBalance:      1000000000 + quote.MinimumBalance(0),
+	}
+}
+
+func TestSwap(t *testing.T) {
+	info := poolInfo()
+	if _, asset2Reserves := quote.Reserves(info); asset2Reserves != 1000000000 {
+		t.Fatalf("Reserves should derive ALGO reserves from the balance, got %d", asset2Reserves)
+	}
+
+	fixedInput, err := quote.FixedInputSwap(info, &types.AssetAmount{Asset: algo, Amount: 1000000}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if fixedInput.AmountOut.Amount != 1992013 || fixedInput.SwapFee.Amount != 3000 || fixedInput.AmountOut.Asset.ID != usdcID {
+		t.Errorf("FixedInputSwap returned %d out with fee %d", fixedInput.AmountOut.Amount, fixedInput.SwapFee.Amount)
+	}
+	if math.Abs(fixedInput.PriceImpactBps-39.935) > 0.0001 || fixedInput.SpotPrice != 2 || fixedInput.ExecutionPrice != fixedInput.Price() {
+		t.Errorf("FixedInputSwap returned a price impact of %f bps", fixedInput.PriceImpactBps)
+	}
+	if fixedInput.ReservesAfter[0].Amount != 1001000000 || fixedInput.ReservesAfter[usdcID].Amount != 2000000000-1992013 {
+		t.Errorf("FixedInputSwap returned wrong reserves after the swap")
+	}
+	if fixedInput.SwapFeeOut.Amount != 6000 || fixedInput.SwapFeeOut.Asset.ID != usdcID {
+		t.Errorf("FixedInputSwap returned a fee of %d in the output asset", fixedInput.SwapFeeOut.Amount)
+	}
+
+	fixedOutput, err := quote.FixedOutputSwap(info, &types.AssetAmount{Asset: usdc, Amount: 1000000}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if fixedOutput.AmountIn.Amount != 501756 || fixedOutput.SwapFee.Amount != 1505 || fixedOutput.AmountIn.Asset.ID != 0 {
+		t.Errorf("FixedOutputSwap returned %d in with fee %d", fixedOutput.AmountIn.Amount, fixedOutput.SwapFee.Amount)
+	}
+
+	impact, err := quote.PriceImpact(info, &types.AssetAmount{Asset: algo, Amount: 1000000})
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if math.Abs(impact-39.935) > 0.0001 {
+		t.Errorf("PriceImpact returned %f bps", impact)
+	}
+
+	if _, err := quote.FixedInputSwap(info, &types.AssetAmount{Asset: &types.Asset{ID: 1}, Amount: 1}, 100); !errors.Is(err, types.ErrAssetMismatch) {
+		t.Errorf("FixedInputSwap should reject an asset outside of the pool, got %v", err)
+	}
+}
+
+func TestMintAndBurn(t *testing.T) {
+	info := poolInfo()
+	mint, err := quote.Mint(info, &types.AssetAmount{Asset: algo, Amount: 500000}, nil, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if mint.AmountsIn[usdcID].Amount != 1000000 || mint.LiquidityAssetAmount.Amount != 500000 {
+		t.Errorf("Mint returned %d USDC for %d liquidity", mint.AmountsIn[usdcID].Amount, mint.LiquidityAssetAmount.Amount)
+	}
+
+	info.IssuedLiquidity = 0
+	first, err := quote.Mint(info, &types.AssetAmount{Asset: algo, Amount: 1000000}, &types.AssetAmount{Asset: usdc, Amount: 4000000}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if first.LiquidityAssetAmount.Amount != 2000000-1000 || first.SlippageBps != 0 {
+		t.Errorf("The first mint returned %d liquidity", first.LiquidityAssetAmount.Amount)
+	}
+	if _, err := quote.Burn(info, &types.AssetAmount{Asset: first.LiquidityAssetAmount.Asset, Amount: 1}, 100); !errors.Is(err, types.ErrInsufficientLiquidity) {
+		t.Errorf("Burn should reject a pool without issued liquidity, got %v", err)
+	}
+
+	info.IssuedLiquidity = 1000000000
+	burn, err := quote.Burn(info, &types.AssetAmount{Asset: first.LiquidityAssetAmount.Asset, Amount: 1000000}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if burn.AmountsOut[usdcID].Amount != 2000000 || burn.AmountsOut[0].Amount != 1000000 {
+		t.Errorf("Burn returned %d USDC and %d ALGO", burn.AmountsOut[usdcID].Amount, burn.AmountsOut[0].Amount)
+	}
+}
+
+func TestMaxInputForPriceImpact(t *testing.T) {
+	// reserves of an ALGO pool with an 8 decimal asset worth far more than a micro ALGO,
+	// a small input swaps for nothing, so the impact only falls under the limit from a larger amount on
+	inputSupply, outputSupply := uint64(2412345678901), uint64(1873456789)
+	within := func(amountIn, maxImpactBps uint64) bool {
+		amountOut, _, err := quote.SwapOut(inputSupply, outputSupply, amountIn)
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		return new(big.Int).Mul(new(big.Int).SetUint64(amountOut*10000), new(big.Int).SetUint64(inputSupply)).Cmp(
+			new(big.Int).Mul(new(big.Int).SetUint64(amountIn*(10000-maxImpactBps)), new(big.Int).SetUint64(outputSupply))) >= 0
+	}
+	if within(1, 100) {
+		t.Fatalf("A single micro ALGO should be beyond the impact")
+	}
+
+	for _, maxImpactBps := range []uint64{50, 100, 500} {
+		amountIn, err := quote.MaxInputForPriceImpact(inputSupply, outputSupply, maxImpactBps)
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+		if amountIn == 0 || !within(amountIn, maxImpactBps) {
+			t.Fatalf("MaxInputForPriceImpact returned %d which is beyond %d bps", amountIn, maxImpactBps)
+		}
+		for larger := amountIn + 1; larger <= amountIn+1000; larger++ {
+			if within(larger, maxImpactBps) {
+				t.Fatalf("MaxInputForPriceImpact returned %d but %d is within %d bps", amountIn, larger, maxImpactBps)
+			}
+		}
+	}
+
+	if amountIn, err := quote.MaxInputForPriceImpact(inputSupply, outputSupply, 20); err != nil || amountIn != 0 {
+		t.Errorf("An impact below the swap fee should allow no input")
+	}
+	if _, err := quote.MaxInputForPriceImpact(inputSupply, outputSupply, 10000); err == nil {
+		t.Errorf("MaxInputForPriceImpact should reject an impact of 10000 bps")
+	}
+}
+
+func TestMaxInputForPriceImpactLargeReserves(t *testing.T) {
+	inputSupply, outputSupply, maxImpactBps := uint64(2969423637467596183), uint64(18055403258713622), uint64(5963)
+	within := func(amountIn uint64) bool {
+		amountOut, _, err := quote.SwapOut(inputSupply, outputSupply, amountIn)
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		out := new(big.Int).Mul(new(big.Int).SetUint64(amountOut), new(big.Int).SetUint64(inputSupply))
+		in := new(big.Int).Mul(new(big.Int).SetUint64(amountIn), new(big.Int).SetUint64(outputSupply))
+
+		return out.Mul(out, big.NewInt(10000)).Cmp(in.Mul(in, new(big.Int).SetUint64(10000-maxImpactBps))) >= 0
+	}
+
+	start := time.Now()
+	amountIn, err := quote.MaxInputForPriceImpact(inputSupply, outputSupply, maxImpactBps)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("MaxInputForPriceImpact took %s", elapsed)
+	}
+	if amountIn == 0 || !within(amountIn) {
+		t.Fatalf("MaxInputForPriceImpact returned %d which is beyond %d bps", amountIn, maxImpactBps)
+	}
+	for larger := amountIn + 1; larger <= amountIn+1000; larger++ {
+		if within(larger) {
+			t.Fatalf("MaxInputForPriceImpact returned %d but %d is within %d bps", amountIn, larger, maxImpactBps)
+		}
+	}
+}
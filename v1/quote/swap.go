@@ -0,0 +1,142 @@
+package quote
+
+import (
+	"fmt"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+)
+
+// SwapOut returns an output amount and a swap fee in the input asset of a fixed input swap against given supplies.
+// The output is ⌊997 × amountIn × outputSupply / (1000 × inputSupply + 997 × amountIn)⌋ which the validator app calculates.
+func SwapOut(inputSupply, outputSupply, amountIn uint64) (uint64, uint64, error) {
+	if inputSupply == 0 || outputSupply == 0 {
+		return 0, 0, fmt.Errorf("pool has no liquidity: %w", types.ErrInsufficientLiquidity)
+	}
+
+	bigAmountInMinusFee := utils.BigIntMul(utils.ToBigUint(amountIn), utils.ToBigUint(997))
+	bigAmountOut := utils.BigIntDiv(
+		utils.BigIntMul(bigAmountInMinusFee, utils.ToBigUint(outputSupply)),
+		utils.BigIntAdd(utils.BigIntMul(utils.ToBigUint(inputSupply), utils.ToBigUint(1000)), bigAmountInMinusFee),
+	)
+	swapFee := amountIn - utils.BigIntDiv(bigAmountInMinusFee, utils.ToBigUint(1000)).Uint64()
+
+	return bigAmountOut.Uint64(), swapFee, nil
+}
+
+// SwapIn returns an input amount and a swap fee in the input asset of a fixed output swap against given supplies.
+// Both steps round up: the input without fee is ⌈inputSupply × amountOut / (outputSupply - amountOut)⌉, and the input is
+// ⌊1000 × inputSupply × amountOut / (997 × (outputSupply - amountOut))⌋ + 1 which the validator app requires, one more than the exact amount when it divides evenly.
+func SwapIn(inputSupply, outputSupply, amountOut uint64) (uint64, uint64, error) {
+	if inputSupply == 0 || outputSupply == 0 {
+		return 0, 0, fmt.Errorf("pool has no liquidity: %w", types.ErrInsufficientLiquidity)
+	}
+	if amountOut >= outputSupply {
+		return 0, 0, fmt.Errorf("output amount %d: %w", amountOut, types.ErrInsufficientLiquidity)
+	}
+
+	product := utils.BigIntMul(utils.ToBigUint(inputSupply), utils.ToBigUint(amountOut))
+	kept := utils.ToBigUint(outputSupply - amountOut)
+	bigAmountInWithoutFee := utils.BigIntDiv(utils.BigIntAdd(product, utils.BigIntSub(kept, utils.ToBigUint(1))), kept)
+	bigAmountIn := utils.BigIntAdd(
+		utils.BigIntDiv(
+			utils.BigIntMul(product, utils.ToBigUint(1000)),
+			utils.BigIntMul(kept, utils.ToBigUint(997)),
+		),
+		utils.ToBigUint(1),
+	)
+	if !bigAmountIn.IsUint64() {
+		return 0, 0, fmt.Errorf("input amount overflows: %w", types.ErrInsufficientLiquidity)
+	}
+
+	return bigAmountIn.Uint64(), utils.BigIntSub(bigAmountIn, bigAmountInWithoutFee).Uint64(), nil
+}
+
+// ProtocolFee returns the liquidity which the v1.1 validator app issues as the protocol fee of a swap,
+// ⌊5 × amountIn × issuedLiquidity / (20000 × inputSupply)⌋ which is worth 0.025% of the input amount
+func ProtocolFee(inputSupply, issuedLiquidity, amountIn uint64) uint64 {
+	if inputSupply == 0 {
+		return 0
+	}
+
+	return utils.BigIntDiv(
+		utils.BigIntMul(utils.BigIntMul(utils.ToBigUint(amountIn), utils.ToBigUint(5)), utils.ToBigUint(issuedLiquidity)),
+		utils.BigIntMul(utils.ToBigUint(inputSupply), utils.ToBigUint(20000)),
+	).Uint64()
+}
+
+// FixedInputSwap returns a fixed input swap quote against a pool snapshot
+func FixedInputSwap(info *types.PoolInfo, amountIn *types.AssetAmount, slippageBps uint64) (*types.SwapQuote, error) {
+	if amountIn == nil {
+		return nil, fmt.Errorf("amountIn is required")
+	}
+
+	assetOutID, inputSupply, outputSupply, err := supplies(info, amountIn.Asset.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	amountOut, swapFee, err := SwapOut(inputSupply, outputSupply, amountIn.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &types.SwapQuote{
+		SwapType: constants.SwapFixedInput,
+		AmountIn: amountIn,
+		AmountOut: &types.AssetAmount{
+			Asset:  asset(info, assetOutID),
+			Amount: amountOut,
+		},
+		SwapFee: &types.AssetAmount{
+			Asset:  amountIn.Asset,
+			Amount: swapFee,
+		},
+		SlippageBps: slippageBps,
+	}
+	SetExecution(quote, inputSupply, outputSupply)
+	if err := quote.SetBounds(); err != nil {
+		return nil, err
+	}
+
+	return quote, nil
+}
+
+// FixedOutputSwap returns a fixed output swap quote against a pool snapshot
+func FixedOutputSwap(info *types.PoolInfo, amountOut *types.AssetAmount, slippageBps uint64) (*types.SwapQuote, error) {
+	if amountOut == nil {
+		return nil, fmt.Errorf("amountOut is required")
+	}
+
+	assetInID, outputSupply, inputSupply, err := supplies(info, amountOut.Asset.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	amountIn, swapFee, err := SwapIn(inputSupply, outputSupply, amountOut.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	assetIn := asset(info, assetInID)
+	quote := &types.SwapQuote{
+		SwapType: constants.SwapFixedOutput,
+		AmountIn: &types.AssetAmount{
+			Asset:  assetIn,
+			Amount: amountIn,
+		},
+		AmountOut: amountOut,
+		SwapFee: &types.AssetAmount{
+			Asset:  assetIn,
+			Amount: swapFee,
+		},
+		SlippageBps: slippageBps,
+	}
+	SetExecution(quote, inputSupply, outputSupply)
+	if err := quote.SetBounds(); err != nil {
+		return nil, err
+	}
+
+	return quote, nil
+}
@@ -0,0 +1,178 @@
+package quote
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+)
+
+// MaxInputForPriceImpact returns the largest input amount of a fixed input swap whose price impact does not exceed maxImpactBps,
+// it is zero when even the swap fee exceeds the impact. The output is rounded down, so the impact is not monotone in the input:
+// just below the amount which reaches the impact without rounding, inputs within and beyond the impact alternate.
+// That amount is found by a binary search over the input, then the search walks down the output amounts which an input gives,
+// the first one with an input within the impact holds the largest input.
+func MaxInputForPriceImpact(inputSupply, outputSupply, maxImpactBps uint64) (uint64, error) {
+	if inputSupply == 0 || outputSupply == 0 {
+		return 0, fmt.Errorf("pool has no liquidity: %w", types.ErrInsufficientLiquidity)
+	}
+	if maxImpactBps >= 10000 {
+		return 0, fmt.Errorf("max price impact must be below 10000 basis points, got %d", maxImpactBps)
+	}
+
+	// without rounding 997 × inputSupply / (1000 × inputSupply + 997 × amountIn) >= (10000 - maxImpactBps) / 10000,
+	// i.e. 997 × (10000 - maxImpactBps) × amountIn <= (9970000 - 1000 × (10000 - maxImpactBps)) × inputSupply,
+	// the rounded output is never larger, so no amount above the last one which holds is within the impact
+	if 1000*(10000-maxImpactBps) >= 9970000 {
+		return 0, nil
+	}
+	bound := utils.BigIntMul(utils.ToBigUint(9970000-1000*(10000-maxImpactBps)), utils.ToBigUint(inputSupply))
+	withinUnrounded := func(amountIn uint64) bool {
+		return utils.BigIntMul(utils.ToBigUint(997*(10000-maxImpactBps)), utils.ToBigUint(amountIn)).Cmp(bound) <= 0
+	}
+	hi := lastTrue(0, math.MaxUint64-inputSupply, withinUnrounded)
+	if hi == 0 {
+		return 0, nil
+	}
+	hiIn := utils.ToBigUint(hi)
+
+	// maxInput returns the largest input which an output amount allows, ⌊amountOut × inputSupply × 10000 / (outputSupply × (10000 - maxImpactBps))⌋
+	maxInput := func(amountOut uint64) *big.Int {
+		return utils.BigIntDiv(
+			utils.BigIntMul(utils.BigIntMul(utils.ToBigUint(amountOut), utils.ToBigUint(inputSupply)), utils.ToBigUint(10000)),
+			utils.BigIntMul(utils.ToBigUint(outputSupply), utils.ToBigUint(10000-maxImpactBps)),
+		)
+	}
+
+	// minInput returns the smallest input which gives an output amount, ⌈1000 × inputSupply × amountOut / (997 × (outputSupply - amountOut))⌉,
+	// it is nil when the output cannot be reached
+	minInput := func(amountOut uint64) *big.Int {
+		if amountOut >= outputSupply {
+			return nil
+		}
+
+		numerator := utils.BigIntMul(utils.BigIntMul(utils.ToBigUint(amountOut), utils.ToBigUint(inputSupply)), utils.ToBigUint(1000))
+		denominator := utils.BigIntMul(utils.ToBigUint(outputSupply-amountOut), utils.ToBigUint(997))
+
+		return utils.BigIntDiv(utils.BigIntAdd(numerator, utils.BigIntSub(denominator, utils.ToBigUint(1))), denominator)
+	}
+
+	amountOut, _, err := SwapOut(inputSupply, outputSupply, hiIn.Uint64())
+	if err != nil {
+		return 0, err
+	}
+
+	// inputs of an output amount range from its smallest input to the smallest input of the next output minus one
+	upper := minInput(amountOut + 1)
+	for {
+		lower := minInput(amountOut)
+		candidate := maxInput(amountOut)
+		if upper != nil && candidate.Cmp(upper) >= 0 {
+			candidate = utils.BigIntSub(upper, utils.ToBigUint(1))
+		}
+		if candidate.Cmp(hiIn) > 0 {
+			candidate = hiIn
+		}
+		if candidate.Cmp(lower) >= 0 {
+			return candidate.Uint64(), nil
+		}
+
+		// outputs which no input gives are skipped by moving to the output of the largest input below this one
+		upper = lower
+		amountOut, _, err = SwapOut(inputSupply, outputSupply, lower.Uint64()-1)
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+// InputForPrice returns the smallest input amount of a fixed input swap which moves the pool price of the input asset,
+// in base units of the output asset, down to a target price, it is zero when the price is already at or below the target
+func InputForPrice(inputSupply, outputSupply uint64, price float64) (uint64, error) {
+	if inputSupply == 0 || outputSupply == 0 {
+		return 0, fmt.Errorf("pool has no liquidity: %w", types.ErrInsufficientLiquidity)
+	}
+	if price <= 0 || math.IsInf(price, 0) || math.IsNaN(price) {
+		return 0, fmt.Errorf("price must be a positive number")
+	}
+
+	target := new(big.Rat).SetFloat64(price)
+
+	// (outputSupply - amountOut) / (inputSupply + amountIn) <= price
+	reached := func(amountIn uint64) bool {
+		amountOut, _, err := SwapOut(inputSupply, outputSupply, amountIn)
+		if err != nil {
+			return false
+		}
+
+		after := new(big.Rat).SetFrac(
+			utils.ToBigUint(outputSupply-amountOut),
+			utils.BigIntAdd(utils.ToBigUint(inputSupply), utils.ToBigUint(amountIn)),
+		)
+
+		return after.Cmp(target) <= 0
+	}
+	if reached(0) {
+		return 0, nil
+	}
+
+	hi := math.MaxUint64 - inputSupply
+	if !reached(hi) {
+		return 0, fmt.Errorf("price %g cannot be reached: %w", price, types.ErrInsufficientLiquidity)
+	}
+
+	return lastTrue(0, hi, func(amountIn uint64) bool { return !reached(amountIn) }) + 1, nil
+}
+
+// InputForOutput returns the smallest input amount of a fixed input swap which gives at least a desired output amount after the swap fee
+func InputForOutput(inputSupply, outputSupply, amountOut uint64) (uint64, error) {
+	if amountOut == 0 {
+		return 0, nil
+	}
+
+	estimate, _, err := SwapIn(inputSupply, outputSupply, amountOut)
+	if err != nil {
+		return 0, err
+	}
+
+	short := func(amountIn uint64) bool {
+		out, _, err := SwapOut(inputSupply, outputSupply, amountIn)
+
+		return err == nil && out < amountOut
+	}
+
+	hi := estimate
+	if hi == 0 {
+		hi = 1
+	}
+	for short(hi) {
+		if hi > (math.MaxUint64-inputSupply)/2 {
+			return 0, fmt.Errorf("output amount %d: %w", amountOut, types.ErrInsufficientLiquidity)
+		}
+
+		hi *= 2
+	}
+
+	return lastTrue(0, hi, short) + 1, nil
+}
+
+// lastTrue returns the largest value in [lo, hi] for which a predicate holds, the predicate has to hold up to a point and fail after it.
+// It returns lo - 1 when the predicate does not hold at lo.
+func lastTrue(lo, hi uint64, ok func(uint64) bool) uint64 {
+	if !ok(lo) {
+		return lo - 1
+	}
+
+	for lo < hi {
+		mid := lo + (hi-lo)/2 + 1
+		if ok(mid) {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+
+	return lo
+}
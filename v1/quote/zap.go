@@ -0,0 +1,63 @@
+package quote
+
+import (
+	"fmt"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+)
+
+// ZapSwapAmount returns the part of a single asset amount to swap before minting with the rest of it and the minimum output of the swap,
+// it is the part which gives the most liquidity against the reserves after the swap
+func ZapSwapAmount(inputSupply, outputSupply, issuedLiquidity, amountIn, slippageBps uint64) (uint64, error) {
+	if inputSupply == 0 || outputSupply == 0 || issuedLiquidity == 0 {
+		return 0, fmt.Errorf("a zap needs a pool with issued liquidity: %w", types.ErrInsufficientLiquidity)
+	}
+
+	// liquidity returns liquidity amounts given by the rest of the input and by the minimum output after swapping a part of the input
+	liquidity := func(swapAmount uint64) (uint64, uint64) {
+		amountOut, _, err := SwapOut(inputSupply, outputSupply, swapAmount)
+		if err != nil {
+			return 0, 0
+		}
+
+		minAmountOut := (&types.AssetAmount{Amount: amountOut}).MinWithSlippage(slippageBps).Amount
+		liquidityIn := utils.BigIntDiv(
+			utils.BigIntMul(utils.ToBigUint(amountIn-swapAmount), utils.ToBigUint(issuedLiquidity)),
+			utils.BigIntAdd(utils.ToBigUint(inputSupply), utils.ToBigUint(swapAmount)),
+		).Uint64()
+		liquidityOut := utils.BigIntDiv(
+			utils.BigIntMul(utils.ToBigUint(minAmountOut), utils.ToBigUint(issuedLiquidity)),
+			utils.ToBigUint(outputSupply-amountOut),
+		).Uint64()
+
+		return liquidityIn, liquidityOut
+	}
+	minted := func(swapAmount uint64) uint64 {
+		liquidityIn, liquidityOut := liquidity(swapAmount)
+		if liquidityOut < liquidityIn {
+			return liquidityOut
+		}
+
+		return liquidityIn
+	}
+
+	if liquidityIn, _ := liquidity(0); liquidityIn == 0 {
+		return 0, fmt.Errorf("amount %d is too small to zap in: %w", amountIn, types.ErrInsufficientLiquidity)
+	}
+
+	// the liquidity of the rest falls and the liquidity of the output rises with the swap amount, the most liquidity is where they cross
+	swapAmount := lastTrue(0, amountIn, func(swapAmount uint64) bool {
+		liquidityIn, liquidityOut := liquidity(swapAmount)
+
+		return liquidityOut < liquidityIn
+	})
+	if swapAmount < amountIn && minted(swapAmount+1) > minted(swapAmount) {
+		swapAmount++
+	}
+	if minted(swapAmount) == 0 {
+		return 0, fmt.Errorf("amount %d is too small to zap in: %w", amountIn, types.ErrInsufficientLiquidity)
+	}
+
+	return swapAmount, nil
+}
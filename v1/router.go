@@ -0,0 +1,204 @@
+package tinyman
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+	"github.com/synycboom/tinyman-go-sdk/v1/pools"
+)
+
+// FetchBestRoute refreshes given pools, or reuses their fresh states when the client has a pool cache, and returns the route of at most maxHops swaps which gives the largest output
+func (c *Client) FetchBestRoute(
+	ctx context.Context,
+	knownPools []*pools.Pool,
+	amountIn *types.AssetAmount,
+	assetOut *types.Asset,
+	maxHops int,
+	slippageBps uint64,
+) (*types.RouteQuote, error) {
+	if amountIn == nil || assetOut == nil {
+		return nil, fmt.Errorf("amountIn and assetOut are required")
+	}
+	if amountIn.Asset.Equal(assetOut) {
+		return nil, fmt.Errorf("input and output assets must be different")
+	}
+	if maxHops <= 0 {
+		maxHops = constants.DefaultMaxRouteHops
+	}
+
+	for _, p := range knownPools {
+		if c.PoolCache != nil {
+			p.UseCache(c.PoolCache)
+		}
+		if err := p.RefreshIfStale(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	route, err := bestRoute(knownPools, amountIn, assetOut, maxHops)
+	if err != nil {
+		return nil, err
+	}
+	if route == nil {
+		return nil, fmt.Errorf("no route found from %s to %s", amountIn.Asset, assetOut)
+	}
+
+	route.SlippageBps = slippageBps
+
+	return route, nil
+}
+
+// PrepareRouteTransactions prepares a plan of one swap transaction group per hop of a route followed by a step which redeems excess amounts.
+// Each hop spends the minimum output of the previous one and is quoted again on that input from the current pool state.
+// Intermediate hops accept an integer share of the route slippage and the last hop accepts the route minimum output.
+// Outputs above the minimum amounts are left as excess in the pools of the hops, the last step reads them once the swaps are confirmed and redeems them,
+// so the plan has to be signed with Sign or SignWith before it is submitted.
+func (c *Client) PrepareRouteTransactions(ctx context.Context, route *types.RouteQuote, swapperAddress string) (*utils.TransactionPlan, error) {
+	if route == nil || len(route.Hops) == 0 {
+		return nil, fmt.Errorf("route is required")
+	}
+	if len(swapperAddress) == 0 {
+		swapperAddress = c.UserAddress
+	}
+
+	minAmountOut, err := route.AmountOutWithSlippage()
+	if err != nil {
+		return nil, err
+	}
+
+	plan := utils.NewTransactionPlan()
+	hopPools := make([]*pools.Pool, len(route.Hops))
+	amountIn := route.AmountIn
+	for idx, hop := range route.Hops {
+		pool, err := c.FetchPool(ctx, &types.Asset{ID: hop.Asset1ID}, &types.Asset{ID: hop.Asset2ID}, true)
+		if err != nil {
+			return nil, err
+		}
+
+		quote, err := pool.FixedInputSwapQuote(amountIn, route.HopSlippageBps())
+		if err != nil {
+			return nil, err
+		}
+		if idx == len(route.Hops)-1 {
+			if quote.AmountOut.Amount < minAmountOut.Amount {
+				return nil, fmt.Errorf("route output %d is below the minimum output %d", quote.AmountOut.Amount, minAmountOut.Amount)
+			}
+
+			quote.MinAmountOut = minAmountOut
+		}
+
+		txGroup, err := pool.PrepareSwapTransactionsFromQuote(ctx, quote, swapperAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		plan.Add(txGroup)
+		hopPools[idx] = pool
+		amountIn = quote.MinAmountOut
+	}
+
+	plan.AddStep(func(ctx context.Context) ([]*utils.TransactionGroup, error) {
+		var redeems []*utils.TransactionGroup
+		for _, pool := range hopPools {
+			quotes, err := pool.FetchExcessAmounts(ctx, swapperAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			for idx := range quotes {
+				if quotes[idx].Amount.Amount == 0 {
+					continue
+				}
+
+				txGroup, err := pool.PrepareRedeemTransactionsFromQuote(ctx, &quotes[idx], swapperAddress)
+				if err != nil {
+					return nil, err
+				}
+
+				redeems = append(redeems, txGroup)
+			}
+		}
+
+		return redeems, nil
+	})
+
+	return plan, nil
+}
+
+// bestRoute searches all routes through pools without visiting an asset twice and returns the one with the largest output
+func bestRoute(knownPools []*pools.Pool, amountIn *types.AssetAmount, assetOut *types.Asset, maxHops int) (*types.RouteQuote, error) {
+	var best *types.RouteQuote
+	var hops []types.RouteHop
+	visited := map[uint64]bool{amountIn.Asset.ID: true}
+	used := make(map[*pools.Pool]bool)
+
+	var search func(amount *types.AssetAmount) error
+	search = func(amount *types.AssetAmount) error {
+		if amount.Asset.Equal(assetOut) {
+			if best == nil || amount.Amount > best.AmountOut.Amount {
+				best = &types.RouteQuote{
+					Hops:      append([]types.RouteHop(nil), hops...),
+					AmountIn:  amountIn,
+					AmountOut: amount,
+				}
+			}
+
+			return nil
+		}
+		if len(hops) == maxHops {
+			return nil
+		}
+
+		for _, p := range knownPools {
+			if used[p] || !(amount.Asset.Equal(p.Asset1) || amount.Asset.Equal(p.Asset2)) {
+				continue
+			}
+
+			quote, err := p.FixedInputSwapQuote(amount, 0)
+			if err != nil || quote.AmountOut.Amount == 0 || visited[quote.AmountOut.Asset.ID] {
+				continue
+			}
+
+			hop, err := routeHop(p, quote)
+			if err != nil {
+				return err
+			}
+
+			used[p] = true
+			visited[quote.AmountOut.Asset.ID] = true
+			hops = append(hops, *hop)
+			if err := search(quote.AmountOut); err != nil {
+				return err
+			}
+			hops = hops[:len(hops)-1]
+			visited[quote.AmountOut.Asset.ID] = false
+			used[p] = false
+		}
+
+		return nil
+	}
+
+	if err := search(amountIn); err != nil {
+		return nil, err
+	}
+
+	return best, nil
+}
+
+func routeHop(p *pools.Pool, quote *types.SwapQuote) (*types.RouteHop, error) {
+	address, err := p.Address()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RouteHop{
+		PoolAddress:      address,
+		Asset1ID:         p.Asset1.ID,
+		Asset2ID:         p.Asset2.ID,
+		LiquidityAssetID: p.LiquidityAsset.ID,
+		Quote:            quote,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package tinyman_test
+
+import (
+	"testing"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/v1/pools"
+	"github.com/synycboom/tinyman-go-sdk/v1/tinymantest"
+)
+
+func TestFetchBestRoute(t *testing.T) {
+	e := tinymantest.New(t)
+	e.OptIn()
+	algo, err := e.Client.FetchAsset(e.Ctx, 0)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	tokenA := e.CreateAsset("TKA")
+	tokenB := e.CreateAsset("TKB")
+	knownPools := []*pools.Pool{
+		e.CreatePool(tokenA, algo, 100000000000, 10000000000),
+		e.CreatePool(tokenB, algo, 200000000000, 10000000000),
+		// the direct pool is too shallow to give the best price
+		e.CreatePool(tokenA, tokenB, 10000000, 20000000),
+	}
+
+	amountIn := &types.AssetAmount{Asset: tokenA, Amount: 1000000000}
+	route, err := e.Client.FetchBestRoute(e.Ctx, knownPools, amountIn, tokenB, 2, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(route.Hops) != 2 || route.Hops[0].Quote.AmountOut.Asset.ID != 0 {
+		t.Fatalf("FetchBestRoute should route through ALGO, got %d hops", len(route.Hops))
+	}
+	if impact := route.PriceImpactBps(); impact <= route.Hops[0].Quote.PriceImpactBps || impact >= 500 {
+		t.Errorf("FetchBestRoute returned wrong price impact %f bps", impact)
+	}
+
+	minOut, err := route.AmountOutWithSlippage()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	plan, err := e.Client.PrepareRouteTransactions(e.Ctx, route, "")
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(plan.Groups()) != 2 {
+		t.Fatalf("Expected a swap group per hop, got %d", len(plan.Groups()))
+	}
+
+	before := e.Sim.Balance(e.Address(), tokenB.ID)
+	if err := plan.Sign(&e.User); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	txIDs, err := plan.Submit(e.Ctx, e.Sim, true)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(txIDs) != 4 {
+		t.Fatalf("Expected two swaps and two redeems, got %d groups", len(txIDs))
+	}
+
+	// the excess of both hops is redeemed, so the user receives more than the minimum output
+	received := e.Sim.Balance(e.Address(), tokenB.ID) - before
+	if received <= minOut.Amount || received > route.AmountOut.Amount {
+		t.Errorf("User received %d, expected between %d and %d", received, minOut.Amount, route.AmountOut.Amount)
+	}
+	for _, hop := range route.Hops {
+		if excess := e.Sim.Excess(e.Address(), hop.PoolAddress, hop.Quote.AmountOut.Asset.ID); excess != 0 {
+			t.Errorf("Excess %d of pool %s should be redeemed", excess, hop.PoolAddress)
+		}
+	}
+}
+
+func TestFetchBestRouteNoRoute(t *testing.T) {
+	e := tinymantest.New(t)
+	e.OptIn()
+	algo, err := e.Client.FetchAsset(e.Ctx, 0)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	tokenA := e.CreateAsset("TKA")
+	tokenB := e.CreateAsset("TKB")
+	knownPools := []*pools.Pool{e.CreatePool(tokenA, algo, 100000000000, 10000000000)}
+
+	amountIn := &types.AssetAmount{Asset: tokenA, Amount: 1000000000}
+	if _, err := e.Client.FetchBestRoute(e.Ctx, knownPools, amountIn, tokenB, 3, 100); err == nil {
+		t.Errorf("FetchBestRoute should return an error when there is no route")
+	}
+}
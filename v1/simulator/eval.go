@@ -0,0 +1,353 @@
+package simulator
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	algoTypes "github.com/algorand/go-algorand-sdk/types"
+	"golang.org/x/crypto/ed25519"
+
+	"github.com/synycboom/tinyman-go-sdk/v1/algodtest"
+)
+
+// evaluator evaluates a single transaction group against a ledger.
+// Logic signatures and app calls run their TEAL programs, so the validator app is evaluated from its bundled approval program.
+type evaluator struct {
+	ledger    *algodtest.Ledger
+	round     uint64
+	timestamp uint64
+	creator   algoTypes.Address
+	group     []algoTypes.SignedTxn
+	applyData []algoTypes.ApplyData
+}
+
+func (e *evaluator) run() error {
+	if len(e.group) > algoTypes.MaxTxGroupSize {
+		return e.reject(0, fmt.Sprintf("group size %d exceeds maximum %d", len(e.group), algoTypes.MaxTxGroupSize))
+	}
+	if err := e.checkGroupID(); err != nil {
+		return err
+	}
+
+	for idx := range e.group {
+		if err := e.verify(idx); err != nil {
+			return err
+		}
+	}
+
+	e.applyData = make([]algoTypes.ApplyData, len(e.group))
+	for idx := range e.group {
+		touched, err := e.apply(idx)
+		if err != nil {
+			return err
+		}
+		if err := e.checkMinBalances(idx, touched); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *evaluator) txID(idx int) string {
+	return crypto.GetTxID(e.group[idx].Txn)
+}
+
+func (e *evaluator) reject(idx int, reason string) error {
+	return &Error{TxID: e.txID(idx), GroupIndex: idx, Reason: reason}
+}
+
+func (e *evaluator) checkGroupID() error {
+	if len(e.group) == 1 && e.group[0].Txn.Group == (algoTypes.Digest{}) {
+		return nil
+	}
+
+	txs := make([]algoTypes.Transaction, len(e.group))
+	for idx, stxn := range e.group {
+		txs[idx] = stxn.Txn
+		txs[idx].Group = algoTypes.Digest{}
+	}
+
+	gid, err := crypto.ComputeGroupID(txs)
+	if err != nil {
+		return err
+	}
+
+	for idx, stxn := range e.group {
+		if stxn.Txn.Group != gid {
+			return e.reject(idx, "transactionGroup: incomplete group")
+		}
+	}
+
+	return nil
+}
+
+// verify checks the signature of a transaction, a logic signature is evaluated against the whole group
+func (e *evaluator) verify(idx int) error {
+	stxn := e.group[idx]
+	signer := stxn.Txn.Sender
+	if !stxn.AuthAddr.IsZero() {
+		signer = stxn.AuthAddr
+	}
+
+	if len(stxn.Lsig.Logic) > 0 {
+		if crypto.LogicSigAddress(stxn.Lsig) != signer {
+			return e.reject(idx, "rejected by logic")
+		}
+
+		pass, err := e.evalProgram(idx, stxn.Lsig.Logic, modeSignature)
+		if err != nil {
+			return e.reject(idx, fmt.Sprintf("rejected by logic err=%s", err.Error()))
+		}
+		if !pass {
+			return e.reject(idx, "rejected by logic")
+		}
+
+		return nil
+	}
+
+	message := append([]byte("TX"), msgpack.Encode(stxn.Txn)...)
+	if !stxn.Msig.Blank() {
+		ma, err := crypto.MultisigAccountFromSig(stxn.Msig)
+		if err != nil {
+			return e.reject(idx, err.Error())
+		}
+
+		addr, err := ma.Address()
+		if err != nil {
+			return e.reject(idx, err.Error())
+		}
+		if addr != signer {
+			return e.reject(idx, "multisig address does not match the signer")
+		}
+
+		signed := 0
+		for _, subsig := range stxn.Msig.Subsigs {
+			if subsig.Sig == (algoTypes.Signature{}) {
+				continue
+			}
+			if !ed25519.Verify(subsig.Key, message, subsig.Sig[:]) {
+				return e.reject(idx, "multisig signature is invalid")
+			}
+
+			signed++
+		}
+		if signed < int(stxn.Msig.Threshold) {
+			return e.reject(idx, "multisig signature does not meet the threshold")
+		}
+
+		return nil
+	}
+
+	if stxn.Sig == (algoTypes.Signature{}) {
+		return e.reject(idx, "signedtxn has no sig")
+	}
+	if !ed25519.Verify(signer[:], message, stxn.Sig[:]) {
+		return e.reject(idx, "signature validation failed")
+	}
+
+	return nil
+}
+
+// apply applies a transaction to the ledger and returns the accounts whose minimum balance has to be checked
+func (e *evaluator) apply(idx int) ([]algoTypes.Address, error) {
+	txn := e.group[idx].Txn
+	touched := []algoTypes.Address{txn.Sender}
+	sender := e.ledger.Account(txn.Sender)
+	if sender.Algo < uint64(txn.Fee) {
+		return nil, e.reject(idx, fmt.Sprintf("overspend (account %s, tried to spend {%d})", txn.Sender.String(), uint64(txn.Fee)))
+	}
+	sender.Algo -= uint64(txn.Fee)
+
+	switch txn.Type {
+	case algoTypes.PaymentTx:
+		touched = append(touched, txn.Receiver)
+		if reason := transfer(e.ledger, 0, txn.Sender, txn.Receiver, uint64(txn.Amount)); reason != "" {
+			return nil, e.reject(idx, reason)
+		}
+		if !txn.CloseRemainderTo.IsZero() {
+			touched = append(touched, txn.CloseRemainderTo)
+			transfer(e.ledger, 0, txn.Sender, txn.CloseRemainderTo, sender.Algo)
+		}
+	case algoTypes.AssetTransferTx:
+		assetID := uint64(txn.XferAsset)
+		touched = append(touched, txn.AssetReceiver)
+		if !txn.AssetSender.IsZero() {
+			return nil, e.reject(idx, "clawback transfers are not supported")
+		}
+		if _, ok := e.ledger.Assets[assetID]; !ok {
+			return nil, e.reject(idx, fmt.Sprintf("asset %d does not exist", assetID))
+		}
+		if txn.Sender == txn.AssetReceiver && txn.AssetAmount == 0 {
+			if _, ok := sender.Assets[assetID]; !ok {
+				sender.Assets[assetID] = 0
+			}
+		} else if reason := transfer(e.ledger, assetID, txn.Sender, txn.AssetReceiver, txn.AssetAmount); reason != "" {
+			return nil, e.reject(idx, reason)
+		}
+		if !txn.AssetCloseTo.IsZero() {
+			touched = append(touched, txn.AssetCloseTo)
+			if reason := transfer(e.ledger, assetID, txn.Sender, txn.AssetCloseTo, sender.Assets[assetID]); reason != "" {
+				return nil, e.reject(idx, reason)
+			}
+
+			delete(sender.Assets, assetID)
+		}
+	case algoTypes.AssetConfigTx:
+		if txn.ConfigAsset != 0 {
+			return nil, e.reject(idx, "asset reconfiguration is not supported")
+		}
+
+		e.applyData[idx].ConfigAsset = e.ledger.CreateAsset(txn.Sender, modelAssetParams(txn))
+	case algoTypes.ApplicationCallTx:
+		touched = append(touched, txn.Accounts...)
+
+		return touched, e.call(idx)
+	default:
+		return nil, e.reject(idx, fmt.Sprintf("unsupported transaction type %s", txn.Type))
+	}
+
+	return touched, nil
+}
+
+// call applies an app call, the approval program decides every on-completion except clearing the state
+func (e *evaluator) call(idx int) error {
+	txn := e.group[idx].Txn
+	appID := uint64(txn.ApplicationID)
+	app, ok := e.ledger.Apps[appID]
+	if !ok {
+		return e.reject(idx, fmt.Sprintf("application %d does not exist", appID))
+	}
+
+	sender := e.ledger.Account(txn.Sender)
+	_, optedIn := sender.Local[appID]
+	switch txn.OnCompletion {
+	case algoTypes.OptInOC:
+		if optedIn {
+			return e.reject(idx, fmt.Sprintf("account %s has already opted in to app %d", txn.Sender.String(), appID))
+		}
+
+		sender.Local[appID] = make(map[string]uint64)
+	case algoTypes.CloseOutOC, algoTypes.ClearStateOC:
+		if !optedIn {
+			return e.reject(idx, fmt.Sprintf("address %s has not opted in to application %d", txn.Sender.String(), appID))
+		}
+	}
+
+	if txn.OnCompletion == algoTypes.ClearStateOC {
+		// the clear state program cannot prevent clearing the state, its result is ignored
+		_, _ = e.evalProgram(idx, app.Params.ClearStateProgram, modeApplication)
+		delete(sender.Local, appID)
+
+		return nil
+	}
+
+	accounts := append([]algoTypes.Address{txn.Sender}, txn.Accounts...)
+	before := make([]map[string]uint64, len(accounts))
+	for i, addr := range accounts {
+		before[i] = copyState(e.ledger.Account(addr).Local[appID])
+	}
+
+	pass, err := e.evalProgram(idx, app.Params.ApprovalProgram, modeApplication)
+	if err != nil {
+		return e.reject(idx, "logic eval error: "+err.Error())
+	}
+	if !pass {
+		return e.reject(idx, "transaction rejected by ApprovalProgram")
+	}
+
+	if txn.OnCompletion == algoTypes.CloseOutOC {
+		delete(sender.Local, appID)
+	}
+
+	schema := app.Params.LocalStateSchema
+	for i, addr := range accounts {
+		after := e.ledger.Account(addr).Local[appID]
+		if count := uint64(len(after)); count > schema.NumUint {
+			return e.reject(idx, fmt.Sprintf("store integer count %d exceeds schema integer count %d", count, schema.NumUint))
+		}
+
+		if delta := stateDelta(before[i], after); len(delta) > 0 {
+			if e.applyData[idx].EvalDelta.LocalDeltas == nil {
+				e.applyData[idx].EvalDelta.LocalDeltas = make(map[uint64]algoTypes.StateDelta)
+			}
+			e.applyData[idx].EvalDelta.LocalDeltas[uint64(i)] = delta
+		}
+	}
+
+	return nil
+}
+
+func copyState(state map[string]uint64) map[string]uint64 {
+	copied := make(map[string]uint64, len(state))
+	for k, v := range state {
+		copied[k] = v
+	}
+
+	return copied
+}
+
+// stateDelta returns a local state delta as a node reports it, changed keys carry their new values
+func stateDelta(before, after map[string]uint64) algoTypes.StateDelta {
+	delta := make(algoTypes.StateDelta)
+	for k, v := range after {
+		if old, ok := before[k]; !ok || old != v {
+			delta[k] = algoTypes.ValueDelta{Action: algoTypes.SetUintAction, Uint: v}
+		}
+	}
+	for k := range before {
+		if _, ok := after[k]; !ok {
+			delta[k] = algoTypes.ValueDelta{Action: algoTypes.DeleteAction}
+		}
+	}
+
+	return delta
+}
+
+// checkMinBalances checks that touched accounts keep their minimum balance, an emptied account is closed instead
+func (e *evaluator) checkMinBalances(idx int, touched []algoTypes.Address) error {
+	for _, addr := range touched {
+		acc, ok := e.ledger.Accounts[addr]
+		if !ok {
+			continue
+		}
+		if acc.Algo == 0 && len(acc.Assets) == 0 && len(acc.Local) == 0 && len(acc.Created) == 0 {
+			delete(e.ledger.Accounts, addr)
+
+			continue
+		}
+
+		if minimum := e.ledger.MinBalance(addr); acc.Algo < minimum {
+			return e.reject(idx, fmt.Sprintf("account %s balance %d below min %d (%d assets)", addr.String(), acc.Algo, minimum, len(acc.Assets)))
+		}
+	}
+
+	return nil
+}
+
+func modelAssetParams(txn algoTypes.Transaction) models.AssetParams {
+	address := func(addr algoTypes.Address) string {
+		if addr.IsZero() {
+			return ""
+		}
+
+		return addr.String()
+	}
+
+	return models.AssetParams{
+		Creator:       txn.Sender.String(),
+		Total:         txn.AssetParams.Total,
+		Decimals:      uint64(txn.AssetParams.Decimals),
+		DefaultFrozen: txn.AssetParams.DefaultFrozen,
+		Name:          txn.AssetParams.AssetName,
+		UnitName:      txn.AssetParams.UnitName,
+		Url:           txn.AssetParams.URL,
+		MetadataHash:  append([]byte(nil), txn.AssetParams.MetadataHash[:]...),
+		Manager:       address(txn.AssetParams.Manager),
+		Reserve:       address(txn.AssetParams.Reserve),
+		Freeze:        address(txn.AssetParams.Freeze),
+		Clawback:      address(txn.AssetParams.Clawback),
+	}
+}
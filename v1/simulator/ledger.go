@@ -0,0 +1,70 @@
+package simulator
+
+import (
+	"fmt"
+
+	algoTypes "github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/synycboom/tinyman-go-sdk/v1/algodtest"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+)
+
+const (
+	// minTxnFee is the minimum fee of a transaction
+	minTxnFee = 1000
+
+	// minBalance is the minimum balance of an account without assets and apps
+	minBalance = constants.MinBalancePerAccount
+
+	// maxTxnLife is the maximum number of rounds a transaction is valid for
+	maxTxnLife = 1000
+)
+
+// transfer moves an amount of an asset, asset id 0 is Algo
+func transfer(l *algodtest.Ledger, assetID uint64, sender, receiver algoTypes.Address, amount uint64) string {
+	from := l.Account(sender)
+	to := l.Account(receiver)
+	if assetID == 0 {
+		if from.Algo < amount {
+			return fmt.Sprintf("overspend (account %s, tried to spend {%d})", sender.String(), amount)
+		}
+
+		from.Algo -= amount
+		to.Algo += amount
+
+		return ""
+	}
+
+	balance, ok := from.Assets[assetID]
+	if !ok {
+		return fmt.Sprintf("asset %d missing from %s", assetID, sender.String())
+	}
+	if _, ok := to.Assets[assetID]; !ok {
+		return fmt.Sprintf("asset %d missing from %s", assetID, receiver.String())
+	}
+	if balance < amount {
+		return fmt.Sprintf("underflow on subtracting %d from sender amount %d", amount, balance)
+	}
+
+	from.Assets[assetID] -= amount
+	to.Assets[assetID] += amount
+
+	return ""
+}
+
+// Error is a rejected transaction group, formatted the way algod reports it
+type Error struct {
+	// TxID is the id of the rejected transaction
+	TxID string
+
+	// GroupIndex is the index of the rejected transaction in its group
+	GroupIndex int
+
+	// Reason is the rejection reason
+	Reason string
+}
+
+// Error returns an error message
+func (e *Error) Error() string {
+	return fmt.Sprintf("TransactionPool.Remember: transaction %s: %s", e.TxID, e.Reason)
+}
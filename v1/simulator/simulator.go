@@ -0,0 +1,137 @@
+// Package simulator provides an in-memory Tinyman AMM which executes prepared transaction groups
+// with the bundled validator app and pool logic signature programs, so that strategies can be tested end to end without a network.
+package simulator
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sync"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	algoTypes "github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/algodtest"
+	"github.com/synycboom/tinyman-go-sdk/v1/contracts"
+)
+
+const (
+	// genesisTimestamp is the timestamp of the first round
+	genesisTimestamp = 1640995200
+
+	// roundTime is the number of seconds between two rounds
+	roundTime = 4
+)
+
+// Simulator is an algodtest.Node running a Tinyman validator app, so a tinyman.Client or pools.Pool can run against it directly.
+// The validator app and pool logic signatures are evaluated by a TEAL interpreter from the registered contracts,
+// so protocol fees, the price oracle and minimum balance requirements behave the way they do on chain.
+// Each executed group is confirmed in its own round.
+type Simulator struct {
+	*algodtest.Node
+
+	mu             sync.Mutex
+	validatorAppID uint64
+	timestamp      uint64
+}
+
+// New creates an empty simulator running a given validator app id,
+// app calls are rejected when contracts of the validator app are not registered
+func New(validatorAppID uint64) *Simulator {
+	s := &Simulator{
+		Node:           algodtest.NewNode(),
+		validatorAppID: validatorAppID,
+		timestamp:      genesisTimestamp,
+	}
+	if validator, err := validatorApp(validatorAppID); err == nil {
+		s.SetApplication(validator)
+	}
+	s.Apply = s.apply
+
+	return s
+}
+
+// ValidatorAppID returns the validator app id run by the simulator
+func (s *Simulator) ValidatorAppID() uint64 {
+	return s.validatorAppID
+}
+
+// SetTimestamp sets the timestamp of the latest round which app calls see, the following rounds advance it by 4 seconds
+func (s *Simulator) SetTimestamp(timestamp uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.timestamp = timestamp
+}
+
+// Excess returns an excess amount of a user in a pool of the validator app
+func (s *Simulator) Excess(userAddress, poolAddress string, assetID uint64) uint64 {
+	return s.Node.Excess(userAddress, s.validatorAppID, poolAddress, assetID)
+}
+
+// Execute submits a signed transaction group, either all of its transactions are applied or none
+func (s *Simulator) Execute(txGroup *utils.TransactionGroup) error {
+	var raw []byte
+	for idx, stxn := range txGroup.SignedTransactions() {
+		if len(stxn) == 0 {
+			return fmt.Errorf("transaction %d is not signed", idx)
+		}
+
+		raw = append(raw, stxn...)
+	}
+
+	_, err := s.SendRawTransaction(context.Background(), raw)
+
+	return err
+}
+
+// apply evaluates a submitted group against a copy of the node ledger, it is installed as the Apply hook of the node
+func (s *Simulator) apply(l *algodtest.Ledger, round uint64, stxns []algoTypes.SignedTxn) ([]algoTypes.ApplyData, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := &evaluator{
+		ledger:    l,
+		round:     round,
+		timestamp: s.timestamp,
+		group:     stxns,
+	}
+	if err := e.run(); err != nil {
+		return nil, err
+	}
+
+	s.timestamp += roundTime
+
+	return e.applyData, nil
+}
+
+// validatorApp loads the programs of a validator app from its registered contracts
+func validatorApp(validatorAppID uint64) (models.Application, error) {
+	asc, err := contracts.ASC(validatorAppID)
+	if err != nil {
+		return models.Application{}, err
+	}
+
+	validator := asc.Contracts.ValidatorApp
+	approval, err := base64.StdEncoding.DecodeString(validator.ApprovalProgram.Bytecode)
+	if err != nil {
+		return models.Application{}, err
+	}
+	clear, err := base64.StdEncoding.DecodeString(validator.ClearProgram.Bytecode)
+	if err != nil {
+		return models.Application{}, err
+	}
+
+	return models.Application{
+		Id: validatorAppID,
+		Params: models.ApplicationParams{
+			ApprovalProgram:   approval,
+			ClearStateProgram: clear,
+			LocalStateSchema: models.ApplicationStateSchema{
+				NumUint:      uint64(validator.LocalStateSchema.NumUints),
+				NumByteSlice: uint64(validator.LocalStateSchema.NumByteSlices),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,300 @@
+package simulator_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/future"
+	algoTypes "github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/pools"
+	"github.com/synycboom/tinyman-go-sdk/v1/simulator"
+	"github.com/synycboom/tinyman-go-sdk/v1/tinymantest"
+)
+
+// tokenPool creates a TKN/ALGO pool with 20000 TKN and 1000 ALGO from the fixture user
+func tokenPool(t *testing.T) (*tinymantest.Fixture, *pools.Pool) {
+	e := tinymantest.New(t)
+	e.OptIn()
+	pool := e.CreatePool(e.CreateAsset("TKN"), e.Asset(0), 20000000000, 1000000000)
+
+	return e, pool
+}
+
+func must(t *testing.T) func(*utils.TransactionGroup, error) *utils.TransactionGroup {
+	return func(txGroup *utils.TransactionGroup, err error) *utils.TransactionGroup {
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		return txGroup
+	}
+}
+
+func TestBootstrapAndMint(t *testing.T) {
+	e, pool := tokenPool(t)
+	if pool.Asset1Reserves != 20000000000 || pool.Asset2Reserves != 1000000000 {
+		t.Errorf("Pool has wrong reserves %d %d", pool.Asset1Reserves, pool.Asset2Reserves)
+	}
+
+	// sqrt(20000000000 * 1000000000) - 1000 is issued to the user
+	position, err := pool.FetchPoolPosition(e.Ctx, "")
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if position.LiquidityAsset.Amount != 4472134954 {
+		t.Errorf("Pool position has wrong liquidity %d", position.LiquidityAsset.Amount)
+	}
+	if pool.IssuedLiquidity != 4472135954 {
+		t.Errorf("Pool has wrong issued liquidity %d", pool.IssuedLiquidity)
+	}
+}
+
+func TestSwapMatchesQuote(t *testing.T) {
+	e, pool := tokenPool(t)
+	poolAddress, err := pool.Address()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	before := e.Sim.Balance(e.Address(), pool.Asset1.ID)
+	quote, err := pool.FetchFixedInputSwapQuote(e.Ctx, &types.AssetAmount{Asset: pool.Asset2, Amount: 10000000}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	amountOutWithSlippage, err := quote.AmountOutWithSlippage()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	e.Submit(pool.PrepareSwapTransactionsFromQuote(e.Ctx, quote, ""))
+
+	received := e.Sim.Balance(e.Address(), pool.Asset1.ID) - before
+	if received != amountOutWithSlippage.Amount {
+		t.Errorf("User received %d instead of %d", received, amountOutWithSlippage.Amount)
+	}
+
+	excess := e.Sim.Excess(e.Address(), poolAddress, pool.Asset1.ID)
+	if excess != quote.AmountOut.Amount-amountOutWithSlippage.Amount {
+		t.Errorf("User has wrong excess %d", excess)
+	}
+
+	quotes, err := e.Client.FetchExcessAmount(e.Ctx, "")
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	redeemQuote, err := pool.GetRedeemQuoteMatchesAssetID(pool.Asset1.ID, quotes)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if redeemQuote == nil || redeemQuote.Amount.Amount != excess {
+		t.Fatalf("FetchExcessAmount returned wrong quotes %v", quotes)
+	}
+
+	e.Submit(pool.PrepareRedeemTransactionsFromQuote(e.Ctx, redeemQuote, ""))
+	if e.Sim.Excess(e.Address(), poolAddress, pool.Asset1.ID) != 0 {
+		t.Errorf("Excess was not redeemed")
+	}
+	if e.Sim.Balance(e.Address(), pool.Asset1.ID)-before != quote.AmountOut.Amount {
+		t.Errorf("User did not receive the full output after redeeming")
+	}
+}
+
+func TestSwapPlanOnFreshAccount(t *testing.T) {
+	e, pool := tokenPool(t)
+	fresh := crypto.GenerateAccount()
+	freshAddress := fresh.Address.String()
+	if err := e.Sim.SetBalance(freshAddress, 0, 100000000); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	quote, err := pool.FetchFixedInputSwapQuote(e.Ctx, &types.AssetAmount{Asset: pool.Asset2, Amount: 10000000}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	amountOutWithSlippage, err := quote.AmountOutWithSlippage()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	plan, err := pool.PrepareSwapPlanFromQuote(e.Ctx, quote, freshAddress)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	// the app and the token opt-ins cannot join the swap group, so they are submitted first
+	if len(plan.Groups()) != 2 || len(plan.Groups()[0].Transactions()) != 2 {
+		t.Fatalf("PrepareSwapPlanFromQuote returned a wrong plan of %d groups", len(plan.Groups()))
+	}
+
+	if err := plan.SignWith(e.Ctx, utils.NewAccountSigner(fresh)); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if _, err := plan.Submit(e.Ctx, e.Sim, true); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if received := e.Sim.Balance(freshAddress, pool.Asset1.ID); received != amountOutWithSlippage.Amount {
+		t.Errorf("User received %d instead of %d", received, amountOutWithSlippage.Amount)
+	}
+
+	// an opted in account needs no pre-flight group
+	plan, err = pool.PrepareSwapPlanFromQuote(e.Ctx, quote, freshAddress)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(plan.Groups()) != 1 {
+		t.Errorf("PrepareSwapPlanFromQuote returned a wrong plan of %d groups", len(plan.Groups()))
+	}
+}
+
+func TestFixedOutputSwapSendsMaxAmountIn(t *testing.T) {
+	e, pool := tokenPool(t)
+	poolAddress, err := pool.Address()
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	quote, err := pool.FetchFixedOutputSwapQuote(e.Ctx, &types.AssetAmount{Asset: pool.Asset1, Amount: 100000000}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if quote.MaxAmountIn.Amount <= quote.AmountIn.Amount {
+		t.Fatalf("FetchFixedOutputSwapQuote returned wrong max amount in %d", quote.MaxAmountIn.Amount)
+	}
+
+	e.Submit(pool.PrepareSwapTransactionsFromQuote(e.Ctx, quote, ""))
+
+	// the unused part of the max amount in is left as excess
+	excess := e.Sim.Excess(e.Address(), poolAddress, 0)
+	if excess != quote.MaxAmountIn.Amount-quote.AmountIn.Amount {
+		t.Errorf("User has wrong excess %d", excess)
+	}
+}
+
+func TestBurn(t *testing.T) {
+	e, pool := tokenPool(t)
+	position, err := pool.FetchPoolPosition(e.Ctx, "")
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	liquidity := position.LiquidityAsset
+	liquidity.Asset = pool.LiquidityAsset
+	liquidity.Amount /= 2
+	quote, err := pool.FetchBurnQuote(e.Ctx, &liquidity, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	e.Submit(pool.PrepareBurnTransactionsFromQuote(e.Ctx, quote, ""))
+	if err := pool.Refresh(e.Ctx, nil); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if pool.IssuedLiquidity != 4472135954-liquidity.Amount {
+		t.Errorf("Pool has wrong issued liquidity %d", pool.IssuedLiquidity)
+	}
+}
+
+func TestRejectSwapSlippage(t *testing.T) {
+	e, pool := tokenPool(t)
+	quote, err := pool.FetchFixedInputSwapQuote(e.Ctx, &types.AssetAmount{Asset: pool.Asset2, Amount: 10000000}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	tooMuch := &types.AssetAmount{Asset: quote.AmountOut.Asset, Amount: quote.AmountOut.Amount + 1}
+	txGroup := must(t)(pool.PrepareSwapTransactions(e.Ctx, quote.AmountIn, tooMuch, quote.SwapType, ""))
+	err = e.TrySubmit(txGroup)
+
+	var simErr *simulator.Error
+	if !errors.As(err, &simErr) {
+		t.Fatalf("Submit should return a simulator error, got %v", err)
+	}
+	if simErr.GroupIndex != 1 || !strings.Contains(simErr.Error(), "logic eval error") {
+		t.Errorf("Submit returned wrong error %s", simErr.Error())
+	}
+	if !errors.Is(err, types.ErrSlippageExceeded) {
+		t.Errorf("Submit should return a slippage error, got %v", err)
+	}
+}
+
+func TestRejectUnsignedGroup(t *testing.T) {
+	e, pool := tokenPool(t)
+	quote, err := pool.FetchFixedInputSwapQuote(e.Ctx, &types.AssetAmount{Asset: pool.Asset2, Amount: 10000000}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	txGroup := must(t)(pool.PrepareSwapTransactionsFromQuote(e.Ctx, quote, ""))
+	if err := e.Sim.Execute(txGroup); err == nil {
+		t.Errorf("Execute should reject a group with unsigned transactions")
+	}
+}
+
+func TestSwapAccruesProtocolFee(t *testing.T) {
+	e, pool := tokenPool(t)
+	issued := pool.IssuedLiquidity
+	algoReserves := pool.Asset2Reserves
+
+	quote, err := pool.FetchFixedInputSwapQuote(e.Ctx, &types.AssetAmount{Asset: pool.Asset2, Amount: 10000000}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	e.Submit(pool.PrepareSwapTransactionsFromQuote(e.Ctx, quote, ""))
+	if err := pool.Refresh(e.Ctx, nil); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	// 10000000 * 5 * 4472135954 / (20000 * 1000000000) liquidity is issued to the protocol
+	if pool.UnclaimedProtocolFee != 11180 || pool.IssuedLiquidity != issued+11180 {
+		t.Errorf("Pool accrued a protocol fee of %d with issued liquidity %d", pool.UnclaimedProtocolFee, pool.IssuedLiquidity)
+	}
+	if pool.OutstandingLiquidityAssetAmount != 1000+11180 {
+		t.Errorf("Pool has wrong outstanding liquidity %d", pool.OutstandingLiquidityAssetAmount)
+	}
+	if pool.Asset2Reserves != algoReserves+10000000 {
+		t.Errorf("Pool should keep the whole input in its reserves, got %d", pool.Asset2Reserves)
+	}
+}
+
+func TestRejectBelowMinimumBalance(t *testing.T) {
+	e, _ := tokenPool(t)
+	account, err := e.Sim.AccountInformation(e.Ctx, e.Address())
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	sp, err := e.Sim.SuggestedParams(e.Ctx)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	// the user holds the token and the liquidity asset and is opted in to the validator app
+	minBalance := uint64(100000 + 2*100000 + 100000 + 16*28500)
+	receiver := crypto.GenerateAccount().Address.String()
+	pay := func(amount uint64) *utils.TransactionGroup {
+		txn, err := future.MakePaymentTxn(e.Address(), receiver, amount, nil, "", sp)
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		txGroup, err := utils.NewTransactionGroup([]algoTypes.Transaction{txn})
+		if err != nil {
+			t.Fatalf("Unexpected err %s", err.Error())
+		}
+
+		return txGroup
+	}
+
+	err = e.TrySubmit(pay(account.Amount - minBalance))
+	if !errors.Is(err, types.ErrInsufficientBalance) || !strings.Contains(err.Error(), "below min") {
+		t.Fatalf("Submit should reject a payment below the minimum balance, got %v", err)
+	}
+
+	e.Submit(pay(account.Amount-minBalance-uint64(sp.MinFee)), nil)
+	if balance := e.Sim.Balance(e.Address(), 0); balance != minBalance {
+		t.Errorf("User should keep the minimum balance, got %d", balance)
+	}
+}
@@ -0,0 +1,1316 @@
+package simulator
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"math/bits"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+	algoTypes "github.com/algorand/go-algorand-sdk/types"
+)
+
+const (
+	// maxTealVersion is the latest TEAL version which the simulator evaluates
+	maxTealVersion = 4
+
+	// logicSigBudget is the cost budget of a logic signature
+	logicSigBudget = 20000
+
+	// appBudget is the cost budget of an application call
+	appBudget = 700
+
+	// maxStringSize is the maximum length of a byte string on the stack
+	maxStringSize = 4096
+
+	// maxAppKeyLength is the maximum length of a key of an application state
+	maxAppKeyLength = 64
+)
+
+// runMode is a mode in which a program is evaluated
+type runMode int
+
+const (
+	// modeSignature evaluates a logic signature, ledger access is not allowed
+	modeSignature runMode = iota
+
+	// modeApplication evaluates an application call
+	modeApplication
+)
+
+// stackValue is a value on the stack or in a scratch slot, the zero value is the uint 0
+type stackValue struct {
+	isBytes bool
+	bytes   []byte
+	uint    uint64
+}
+
+func (v stackValue) typeName() string {
+	if v.isBytes {
+		return "[]byte"
+	}
+
+	return "uint64"
+}
+
+func uintValue(v uint64) stackValue {
+	return stackValue{uint: v}
+}
+
+func bytesValue(b []byte) stackValue {
+	return stackValue{isBytes: true, bytes: b}
+}
+
+func boolValue(b bool) stackValue {
+	if b {
+		return uintValue(1)
+	}
+
+	return uintValue(0)
+}
+
+// tealError is a failed program evaluation, formatted the way algod reports it
+type tealError struct {
+	pc     int
+	opcode string
+	msg    string
+}
+
+// Error returns an error message
+func (e *tealError) Error() string {
+	if e.opcode == "assert" {
+		return fmt.Sprintf("assert failed pc=%d", e.pc)
+	}
+
+	return fmt.Sprintf("%s. Details: pc=%d, opcodes=%s", e.msg, e.pc, e.opcode)
+}
+
+// opSpec is an opcode of the evaluated TEAL subset
+type opSpec struct {
+	name    string
+	args    int
+	cost    int
+	appOnly bool
+	eval    func(te *tealEval) error
+}
+
+// opcodes are the opcodes the simulator evaluates keyed by their byte, other opcodes are rejected as illegal
+var opcodes map[byte]*opSpec
+
+func init() {
+	opcodes = map[byte]*opSpec{
+		0x00: {name: "err", eval: opErr},
+		0x08: {name: "+", args: 2, eval: opPlus},
+		0x09: {name: "-", args: 2, eval: opMinus},
+		0x0a: {name: "/", args: 2, eval: opDiv},
+		0x0b: {name: "*", args: 2, eval: opMul},
+		0x0c: {name: "<", args: 2, eval: compareUint(func(a, b uint64) bool { return a < b })},
+		0x0d: {name: ">", args: 2, eval: compareUint(func(a, b uint64) bool { return a > b })},
+		0x0e: {name: "<=", args: 2, eval: compareUint(func(a, b uint64) bool { return a <= b })},
+		0x0f: {name: ">=", args: 2, eval: compareUint(func(a, b uint64) bool { return a >= b })},
+		0x10: {name: "&&", args: 2, eval: compareUint(func(a, b uint64) bool { return a != 0 && b != 0 })},
+		0x11: {name: "||", args: 2, eval: compareUint(func(a, b uint64) bool { return a != 0 || b != 0 })},
+		0x12: {name: "==", args: 2, eval: opEqual(false)},
+		0x13: {name: "!=", args: 2, eval: opEqual(true)},
+		0x14: {name: "!", args: 1, eval: opNot},
+		0x15: {name: "len", args: 1, eval: opLen},
+		0x16: {name: "itob", args: 1, eval: opItob},
+		0x17: {name: "btoi", args: 1, eval: opBtoi},
+		0x18: {name: "%", args: 2, eval: opMod},
+		0x19: {name: "|", args: 2, eval: bitwise(func(a, b uint64) uint64 { return a | b })},
+		0x1a: {name: "&", args: 2, eval: bitwise(func(a, b uint64) uint64 { return a & b })},
+		0x1b: {name: "^", args: 2, eval: bitwise(func(a, b uint64) uint64 { return a ^ b })},
+		0x1c: {name: "~", args: 1, eval: opBitNot},
+		0x1d: {name: "mulw", args: 2, eval: opMulw},
+		0x1e: {name: "addw", args: 2, eval: opAddw},
+		0x1f: {name: "divmodw", args: 4, cost: 20, eval: opDivmodw},
+		0x20: {name: "intcblock", eval: opIntcblock},
+		0x21: {name: "intc", eval: opIntc},
+		0x22: {name: "intc_0", eval: intcN(0)},
+		0x23: {name: "intc_1", eval: intcN(1)},
+		0x24: {name: "intc_2", eval: intcN(2)},
+		0x25: {name: "intc_3", eval: intcN(3)},
+		0x26: {name: "bytecblock", eval: opBytecblock},
+		0x27: {name: "bytec", eval: opBytec},
+		0x28: {name: "bytec_0", eval: bytecN(0)},
+		0x29: {name: "bytec_1", eval: bytecN(1)},
+		0x2a: {name: "bytec_2", eval: bytecN(2)},
+		0x2b: {name: "bytec_3", eval: bytecN(3)},
+		0x31: {name: "txn", eval: opTxn},
+		0x32: {name: "global", eval: opGlobal},
+		0x33: {name: "gtxn", eval: opGtxn},
+		0x34: {name: "load", eval: opLoad},
+		0x35: {name: "store", args: 1, eval: opStore},
+		0x36: {name: "txna", eval: opTxna},
+		0x37: {name: "gtxna", eval: opGtxna},
+		0x40: {name: "bnz", args: 1, eval: opBnz},
+		0x41: {name: "bz", args: 1, eval: opBz},
+		0x42: {name: "b", eval: opB},
+		0x43: {name: "return", args: 1, eval: opReturn},
+		0x44: {name: "assert", args: 1, eval: opAssert},
+		0x48: {name: "pop", args: 1, eval: opPop},
+		0x49: {name: "dup", args: 1, eval: opDup},
+		0x4a: {name: "dup2", args: 2, eval: opDup2},
+		0x4c: {name: "swap", args: 2, eval: opSwap},
+		0x4d: {name: "select", args: 3, eval: opSelect},
+		0x50: {name: "concat", args: 2, eval: opConcat},
+		0x51: {name: "substring", args: 1, eval: opSubstring},
+		0x52: {name: "substring3", args: 3, eval: opSubstring3},
+		0x60: {name: "balance", args: 1, appOnly: true, eval: opBalance},
+		0x62: {name: "app_local_get", args: 2, appOnly: true, eval: opAppLocalGet},
+		0x66: {name: "app_local_put", args: 3, appOnly: true, eval: opAppLocalPut},
+		0x68: {name: "app_local_del", args: 2, appOnly: true, eval: opAppLocalDel},
+		0x70: {name: "asset_holding_get", args: 2, appOnly: true, eval: opAssetHoldingGet},
+		0x71: {name: "asset_params_get", args: 1, appOnly: true, eval: opAssetParamsGet},
+		0x78: {name: "min_balance", args: 1, appOnly: true, eval: opMinBalance},
+		0x80: {name: "pushbytes", eval: opPushbytes},
+		0x81: {name: "pushint", eval: opPushint},
+	}
+}
+
+// tealEval is an evaluation of a program for a transaction of the evaluated group
+type tealEval struct {
+	e       *evaluator
+	idx     int
+	mode    runMode
+	program []byte
+	pc      int
+	next    int
+	stack   []stackValue
+	scratch [256]stackValue
+	intc    []uint64
+	bytec   [][]byte
+	cost    int
+	budget  int
+	done    bool
+}
+
+// evalProgram evaluates a program for the transaction at a given index of the group and returns whether it approves it
+func (e *evaluator) evalProgram(idx int, program []byte, mode runMode) (bool, error) {
+	te := &tealEval{e: e, idx: idx, mode: mode, program: program, budget: logicSigBudget}
+	if mode == modeApplication {
+		te.budget = appBudget
+	}
+
+	return te.run()
+}
+
+func (te *tealEval) run() (bool, error) {
+	version, n := binary.Uvarint(te.program)
+	if n <= 0 {
+		return false, fmt.Errorf("invalid version")
+	}
+	if version > maxTealVersion {
+		return false, fmt.Errorf("program version %d greater than max supported version %d", version, maxTealVersion)
+	}
+
+	te.pc = n
+	for !te.done && te.pc < len(te.program) {
+		op, ok := opcodes[te.program[te.pc]]
+		if !ok {
+			return false, te.fail("", fmt.Sprintf("illegal opcode 0x%02x", te.program[te.pc]))
+		}
+		if op.appOnly && te.mode != modeApplication {
+			return false, te.fail(op.name, fmt.Sprintf("%s not allowed in current mode", op.name))
+		}
+		if len(te.stack) < op.args {
+			return false, te.fail(op.name, fmt.Sprintf("stack underflow in %s", op.name))
+		}
+
+		cost := op.cost
+		if cost == 0 {
+			cost = 1
+		}
+		te.cost += cost
+		if te.cost > te.budget {
+			return false, te.fail(op.name, fmt.Sprintf("dynamic cost budget exceeded, executing %s", op.name))
+		}
+
+		te.next = te.pc + 1
+		if err := op.eval(te); err != nil {
+			if _, ok := err.(*tealError); ok {
+				return false, err
+			}
+
+			return false, te.fail(op.name, err.Error())
+		}
+		if len(te.stack) > 1000 {
+			return false, te.fail(op.name, "stack overflow")
+		}
+
+		te.pc = te.next
+	}
+
+	if len(te.stack) != 1 {
+		return false, fmt.Errorf("stack len is %d instead of 1", len(te.stack))
+	}
+	if te.stack[0].isBytes {
+		return false, fmt.Errorf("stack finished with bytes not int")
+	}
+
+	return te.stack[0].uint != 0, nil
+}
+
+func (te *tealEval) fail(opcode, msg string) error {
+	return &tealError{pc: te.pc, opcode: opcode, msg: msg}
+}
+
+func (te *tealEval) txn() *algoTypes.Transaction {
+	return &te.e.group[te.idx].Txn
+}
+
+func (te *tealEval) push(v stackValue) {
+	te.stack = append(te.stack, v)
+}
+
+func (te *tealEval) pop() stackValue {
+	v := te.stack[len(te.stack)-1]
+	te.stack = te.stack[:len(te.stack)-1]
+
+	return v
+}
+
+func (te *tealEval) popUint() (uint64, error) {
+	v := te.pop()
+	if v.isBytes {
+		return 0, fmt.Errorf("%s arg wanted type uint64 got []byte", opcodes[te.program[te.pc]].name)
+	}
+
+	return v.uint, nil
+}
+
+func (te *tealEval) popBytes() ([]byte, error) {
+	v := te.pop()
+	if !v.isBytes {
+		return nil, fmt.Errorf("%s arg wanted type []byte got uint64", opcodes[te.program[te.pc]].name)
+	}
+
+	return v.bytes, nil
+}
+
+// popUints pops n uints and returns them in the order they were pushed
+func (te *tealEval) popUints(n int) ([]uint64, error) {
+	values := make([]uint64, n)
+	for i := n - 1; i >= 0; i-- {
+		v, err := te.popUint()
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = v
+	}
+
+	return values, nil
+}
+
+// immediate returns n immediate bytes of the current opcode
+func (te *tealEval) immediate(n int) ([]byte, error) {
+	if te.next+n > len(te.program) {
+		return nil, fmt.Errorf("%s opcode was missing immediates", opcodes[te.program[te.pc]].name)
+	}
+
+	imm := te.program[te.next : te.next+n]
+	te.next += n
+
+	return imm, nil
+}
+
+func (te *tealEval) immediateUvarint() (uint64, error) {
+	v, n := binary.Uvarint(te.program[te.next:])
+	if n <= 0 {
+		return 0, fmt.Errorf("could not decode int at pc=%d", te.next)
+	}
+	te.next += n
+
+	return v, nil
+}
+
+func (te *tealEval) immediateBytes() ([]byte, error) {
+	length, err := te.immediateUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if length > uint64(len(te.program)-te.next) {
+		return nil, fmt.Errorf("bytes list ran past end of program")
+	}
+
+	return te.immediate(int(length))
+}
+
+func opErr(te *tealEval) error {
+	return fmt.Errorf("err opcode executed")
+}
+
+func opPlus(te *tealEval) error {
+	v, err := te.popUints(2)
+	if err != nil {
+		return err
+	}
+
+	sum, carry := bits.Add64(v[0], v[1], 0)
+	if carry != 0 {
+		return fmt.Errorf("+ overflowed")
+	}
+	te.push(uintValue(sum))
+
+	return nil
+}
+
+func opMinus(te *tealEval) error {
+	v, err := te.popUints(2)
+	if err != nil {
+		return err
+	}
+	if v[1] > v[0] {
+		return fmt.Errorf("- would result negative")
+	}
+	te.push(uintValue(v[0] - v[1]))
+
+	return nil
+}
+
+func opDiv(te *tealEval) error {
+	v, err := te.popUints(2)
+	if err != nil {
+		return err
+	}
+	if v[1] == 0 {
+		return fmt.Errorf("/ 0")
+	}
+	te.push(uintValue(v[0] / v[1]))
+
+	return nil
+}
+
+func opMod(te *tealEval) error {
+	v, err := te.popUints(2)
+	if err != nil {
+		return err
+	}
+	if v[1] == 0 {
+		return fmt.Errorf("%% 0")
+	}
+	te.push(uintValue(v[0] % v[1]))
+
+	return nil
+}
+
+func opMul(te *tealEval) error {
+	v, err := te.popUints(2)
+	if err != nil {
+		return err
+	}
+
+	hi, lo := bits.Mul64(v[0], v[1])
+	if hi != 0 {
+		return fmt.Errorf("* overflowed")
+	}
+	te.push(uintValue(lo))
+
+	return nil
+}
+
+func compareUint(cmp func(a, b uint64) bool) func(te *tealEval) error {
+	return func(te *tealEval) error {
+		v, err := te.popUints(2)
+		if err != nil {
+			return err
+		}
+		te.push(boolValue(cmp(v[0], v[1])))
+
+		return nil
+	}
+}
+
+func bitwise(op func(a, b uint64) uint64) func(te *tealEval) error {
+	return func(te *tealEval) error {
+		v, err := te.popUints(2)
+		if err != nil {
+			return err
+		}
+		te.push(uintValue(op(v[0], v[1])))
+
+		return nil
+	}
+}
+
+func opEqual(not bool) func(te *tealEval) error {
+	return func(te *tealEval) error {
+		b := te.pop()
+		a := te.pop()
+		if a.isBytes != b.isBytes {
+			return fmt.Errorf("cannot compare (%s to %s)", a.typeName(), b.typeName())
+		}
+
+		equal := a.uint == b.uint
+		if a.isBytes {
+			equal = bytes.Equal(a.bytes, b.bytes)
+		}
+		te.push(boolValue(equal != not))
+
+		return nil
+	}
+}
+
+func opNot(te *tealEval) error {
+	v, err := te.popUint()
+	if err != nil {
+		return err
+	}
+	te.push(boolValue(v == 0))
+
+	return nil
+}
+
+func opBitNot(te *tealEval) error {
+	v, err := te.popUint()
+	if err != nil {
+		return err
+	}
+	te.push(uintValue(^v))
+
+	return nil
+}
+
+func opLen(te *tealEval) error {
+	b, err := te.popBytes()
+	if err != nil {
+		return err
+	}
+	te.push(uintValue(uint64(len(b))))
+
+	return nil
+}
+
+func opItob(te *tealEval) error {
+	v, err := te.popUint()
+	if err != nil {
+		return err
+	}
+
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	te.push(bytesValue(b))
+
+	return nil
+}
+
+func opBtoi(te *tealEval) error {
+	b, err := te.popBytes()
+	if err != nil {
+		return err
+	}
+	if len(b) > 8 {
+		return fmt.Errorf("btoi arg too long, got [%d]bytes", len(b))
+	}
+
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	te.push(uintValue(v))
+
+	return nil
+}
+
+// opMulw pushes the high and the low words of a product
+func opMulw(te *tealEval) error {
+	v, err := te.popUints(2)
+	if err != nil {
+		return err
+	}
+
+	hi, lo := bits.Mul64(v[0], v[1])
+	te.push(uintValue(hi))
+	te.push(uintValue(lo))
+
+	return nil
+}
+
+// opAddw pushes the carry and the low word of a sum
+func opAddw(te *tealEval) error {
+	v, err := te.popUints(2)
+	if err != nil {
+		return err
+	}
+
+	sum, carry := bits.Add64(v[0], v[1], 0)
+	te.push(uintValue(carry))
+	te.push(uintValue(sum))
+
+	return nil
+}
+
+// opDivmodw divides two 128-bit numbers and pushes the high and the low words of the quotient and of the remainder
+func opDivmodw(te *tealEval) error {
+	v, err := te.popUints(4)
+	if err != nil {
+		return err
+	}
+
+	word := func(hi, lo uint64) *big.Int {
+		n := new(big.Int).Lsh(new(big.Int).SetUint64(hi), 64)
+
+		return n.Or(n, new(big.Int).SetUint64(lo))
+	}
+	dividend := word(v[0], v[1])
+	divisor := word(v[2], v[3])
+	if divisor.Sign() == 0 {
+		return fmt.Errorf("/ 0")
+	}
+
+	quotient, remainder := new(big.Int).QuoRem(dividend, divisor, new(big.Int))
+	mask := new(big.Int).SetUint64(^uint64(0))
+	for _, n := range []*big.Int{quotient, remainder} {
+		te.push(uintValue(new(big.Int).Rsh(n, 64).Uint64()))
+		te.push(uintValue(new(big.Int).And(n, mask).Uint64()))
+	}
+
+	return nil
+}
+
+func opIntcblock(te *tealEval) error {
+	count, err := te.immediateUvarint()
+	if err != nil {
+		return err
+	}
+	if count > uint64(len(te.program)) {
+		return fmt.Errorf("intcblock too long")
+	}
+
+	te.intc = make([]uint64, count)
+	for i := range te.intc {
+		if te.intc[i], err = te.immediateUvarint(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func opBytecblock(te *tealEval) error {
+	count, err := te.immediateUvarint()
+	if err != nil {
+		return err
+	}
+	if count > uint64(len(te.program)) {
+		return fmt.Errorf("bytecblock too long")
+	}
+
+	te.bytec = make([][]byte, count)
+	for i := range te.bytec {
+		if te.bytec[i], err = te.immediateBytes(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (te *tealEval) pushIntc(i int) error {
+	if i >= len(te.intc) {
+		return fmt.Errorf("intc %d beyond %d constants", i, len(te.intc))
+	}
+	te.push(uintValue(te.intc[i]))
+
+	return nil
+}
+
+func (te *tealEval) pushBytec(i int) error {
+	if i >= len(te.bytec) {
+		return fmt.Errorf("bytec %d beyond %d constants", i, len(te.bytec))
+	}
+	te.push(bytesValue(te.bytec[i]))
+
+	return nil
+}
+
+func opIntc(te *tealEval) error {
+	imm, err := te.immediate(1)
+	if err != nil {
+		return err
+	}
+
+	return te.pushIntc(int(imm[0]))
+}
+
+func intcN(i int) func(te *tealEval) error {
+	return func(te *tealEval) error {
+		return te.pushIntc(i)
+	}
+}
+
+func opBytec(te *tealEval) error {
+	imm, err := te.immediate(1)
+	if err != nil {
+		return err
+	}
+
+	return te.pushBytec(int(imm[0]))
+}
+
+func bytecN(i int) func(te *tealEval) error {
+	return func(te *tealEval) error {
+		return te.pushBytec(i)
+	}
+}
+
+func opPushbytes(te *tealEval) error {
+	b, err := te.immediateBytes()
+	if err != nil {
+		return err
+	}
+	te.push(bytesValue(b))
+
+	return nil
+}
+
+func opPushint(te *tealEval) error {
+	v, err := te.immediateUvarint()
+	if err != nil {
+		return err
+	}
+	te.push(uintValue(v))
+
+	return nil
+}
+
+func opTxn(te *tealEval) error {
+	imm, err := te.immediate(1)
+	if err != nil {
+		return err
+	}
+
+	return te.pushTxnField(te.idx, imm[0], 0, false)
+}
+
+func opTxna(te *tealEval) error {
+	imm, err := te.immediate(2)
+	if err != nil {
+		return err
+	}
+
+	return te.pushTxnField(te.idx, imm[0], int(imm[1]), true)
+}
+
+func opGtxn(te *tealEval) error {
+	imm, err := te.immediate(2)
+	if err != nil {
+		return err
+	}
+
+	return te.pushTxnField(int(imm[0]), imm[1], 0, false)
+}
+
+func opGtxna(te *tealEval) error {
+	imm, err := te.immediate(3)
+	if err != nil {
+		return err
+	}
+
+	return te.pushTxnField(int(imm[0]), imm[1], int(imm[2]), true)
+}
+
+func (te *tealEval) pushTxnField(groupIndex int, field byte, arrayIndex int, array bool) error {
+	if groupIndex >= len(te.e.group) {
+		return fmt.Errorf("gtxn lookup TxnGroup[%d] but it only has %d", groupIndex, len(te.e.group))
+	}
+
+	v, err := txnField(te.e.group[groupIndex].Txn, groupIndex, field, arrayIndex, array)
+	if err != nil {
+		return err
+	}
+	te.push(v)
+
+	return nil
+}
+
+// transactionTypeEnums are values of the TypeEnum transaction field
+var transactionTypeEnums = map[algoTypes.TxType]uint64{
+	algoTypes.PaymentTx:         1,
+	algoTypes.KeyRegistrationTx: 2,
+	algoTypes.AssetConfigTx:     3,
+	algoTypes.AssetTransferTx:   4,
+	algoTypes.AssetFreezeTx:     5,
+	algoTypes.ApplicationCallTx: 6,
+}
+
+// txnField returns a transaction field by its TEAL field index, array fields are indexed by arrayIndex
+func txnField(txn algoTypes.Transaction, groupIndex int, field byte, arrayIndex int, array bool) (stackValue, error) {
+	isArray := field == 26 || field == 28 || field == 48 || field == 50
+	if isArray != array {
+		return stackValue{}, fmt.Errorf("invalid txn field %d", field)
+	}
+
+	address := func(addr algoTypes.Address) stackValue {
+		return bytesValue(append([]byte(nil), addr[:]...))
+	}
+	index := func(length int) error {
+		if arrayIndex >= length {
+			return fmt.Errorf("invalid array index %d of txn field %d", arrayIndex, field)
+		}
+
+		return nil
+	}
+
+	switch field {
+	case 0:
+		return address(txn.Sender), nil
+	case 1:
+		return uintValue(uint64(txn.Fee)), nil
+	case 2:
+		return uintValue(uint64(txn.FirstValid)), nil
+	case 4:
+		return uintValue(uint64(txn.LastValid)), nil
+	case 5:
+		return bytesValue(txn.Note), nil
+	case 6:
+		return bytesValue(append([]byte(nil), txn.Lease[:]...)), nil
+	case 7:
+		return address(txn.Receiver), nil
+	case 8:
+		return uintValue(uint64(txn.Amount)), nil
+	case 9:
+		return address(txn.CloseRemainderTo), nil
+	case 15:
+		return bytesValue([]byte(txn.Type)), nil
+	case 16:
+		return uintValue(transactionTypeEnums[txn.Type]), nil
+	case 17:
+		return uintValue(uint64(txn.XferAsset)), nil
+	case 18:
+		return uintValue(txn.AssetAmount), nil
+	case 19:
+		return address(txn.AssetSender), nil
+	case 20:
+		return address(txn.AssetReceiver), nil
+	case 21:
+		return address(txn.AssetCloseTo), nil
+	case 22:
+		return uintValue(uint64(groupIndex)), nil
+	case 23:
+		txID := crypto.TransactionID(txn)
+
+		return bytesValue(txID), nil
+	case 24:
+		return uintValue(uint64(txn.ApplicationID)), nil
+	case 25:
+		return uintValue(uint64(txn.OnCompletion)), nil
+	case 26:
+		if err := index(len(txn.ApplicationArgs)); err != nil {
+			return stackValue{}, err
+		}
+
+		return bytesValue(txn.ApplicationArgs[arrayIndex]), nil
+	case 27:
+		return uintValue(uint64(len(txn.ApplicationArgs))), nil
+	case 28:
+		if arrayIndex == 0 {
+			return address(txn.Sender), nil
+		}
+		if err := index(len(txn.Accounts) + 1); err != nil {
+			return stackValue{}, err
+		}
+
+		return address(txn.Accounts[arrayIndex-1]), nil
+	case 29:
+		return uintValue(uint64(len(txn.Accounts))), nil
+	case 32:
+		return address(txn.RekeyTo), nil
+	case 33:
+		return uintValue(uint64(txn.ConfigAsset)), nil
+	case 34:
+		return uintValue(txn.AssetParams.Total), nil
+	case 35:
+		return uintValue(uint64(txn.AssetParams.Decimals)), nil
+	case 36:
+		return boolValue(txn.AssetParams.DefaultFrozen), nil
+	case 37:
+		return bytesValue([]byte(txn.AssetParams.UnitName)), nil
+	case 38:
+		return bytesValue([]byte(txn.AssetParams.AssetName)), nil
+	case 39:
+		return bytesValue([]byte(txn.AssetParams.URL)), nil
+	case 40:
+		return bytesValue(append([]byte(nil), txn.AssetParams.MetadataHash[:]...)), nil
+	case 41:
+		return address(txn.AssetParams.Manager), nil
+	case 42:
+		return address(txn.AssetParams.Reserve), nil
+	case 43:
+		return address(txn.AssetParams.Freeze), nil
+	case 44:
+		return address(txn.AssetParams.Clawback), nil
+	case 48:
+		if err := index(len(txn.ForeignAssets)); err != nil {
+			return stackValue{}, err
+		}
+
+		return uintValue(uint64(txn.ForeignAssets[arrayIndex])), nil
+	case 49:
+		return uintValue(uint64(len(txn.ForeignAssets))), nil
+	case 50:
+		if arrayIndex == 0 {
+			return uintValue(uint64(txn.ApplicationID)), nil
+		}
+		if err := index(len(txn.ForeignApps) + 1); err != nil {
+			return stackValue{}, err
+		}
+
+		return uintValue(uint64(txn.ForeignApps[arrayIndex-1])), nil
+	case 51:
+		return uintValue(uint64(len(txn.ForeignApps))), nil
+	}
+
+	return stackValue{}, fmt.Errorf("invalid txn field %d", field)
+}
+
+func opGlobal(te *tealEval) error {
+	imm, err := te.immediate(1)
+	if err != nil {
+		return err
+	}
+
+	field := imm[0]
+	if field >= 6 && te.mode != modeApplication {
+		return fmt.Errorf("global field %d not allowed in current mode", field)
+	}
+
+	switch field {
+	case 0:
+		te.push(uintValue(minTxnFee))
+	case 1:
+		te.push(uintValue(minBalance))
+	case 2:
+		te.push(uintValue(maxTxnLife))
+	case 3:
+		te.push(bytesValue(make([]byte, len(algoTypes.Address{}))))
+	case 4:
+		te.push(uintValue(uint64(len(te.e.group))))
+	case 5:
+		te.push(uintValue(maxTealVersion))
+	case 6:
+		te.push(uintValue(te.e.round))
+	case 7:
+		te.push(uintValue(te.e.timestamp))
+	case 8:
+		te.push(uintValue(uint64(te.txn().ApplicationID)))
+	case 9:
+		te.push(bytesValue(append([]byte(nil), te.e.creator[:]...)))
+	default:
+		return fmt.Errorf("invalid global field %d", field)
+	}
+
+	return nil
+}
+
+func opLoad(te *tealEval) error {
+	imm, err := te.immediate(1)
+	if err != nil {
+		return err
+	}
+	te.push(te.scratch[imm[0]])
+
+	return nil
+}
+
+func opStore(te *tealEval) error {
+	imm, err := te.immediate(1)
+	if err != nil {
+		return err
+	}
+	te.scratch[imm[0]] = te.pop()
+
+	return nil
+}
+
+func (te *tealEval) branch() error {
+	imm, err := te.immediate(2)
+	if err != nil {
+		return err
+	}
+
+	target := te.next + int(int16(binary.BigEndian.Uint16(imm)))
+	if target < 0 || target > len(te.program) {
+		return fmt.Errorf("branch target %d outside of program", target)
+	}
+	te.next = target
+
+	return nil
+}
+
+func opBnz(te *tealEval) error {
+	v, err := te.popUint()
+	if err != nil {
+		return err
+	}
+	if v == 0 {
+		te.next += 2
+
+		return nil
+	}
+
+	return te.branch()
+}
+
+func opBz(te *tealEval) error {
+	v, err := te.popUint()
+	if err != nil {
+		return err
+	}
+	if v != 0 {
+		te.next += 2
+
+		return nil
+	}
+
+	return te.branch()
+}
+
+func opB(te *tealEval) error {
+	return te.branch()
+}
+
+func opReturn(te *tealEval) error {
+	v := te.pop()
+	te.stack = append(te.stack[:0], v)
+	te.done = true
+
+	return nil
+}
+
+func opAssert(te *tealEval) error {
+	v, err := te.popUint()
+	if err != nil {
+		return err
+	}
+	if v == 0 {
+		return te.fail("assert", "assert failed")
+	}
+
+	return nil
+}
+
+func opPop(te *tealEval) error {
+	te.pop()
+
+	return nil
+}
+
+func opDup(te *tealEval) error {
+	te.push(te.stack[len(te.stack)-1])
+
+	return nil
+}
+
+func opDup2(te *tealEval) error {
+	te.push(te.stack[len(te.stack)-2])
+	te.push(te.stack[len(te.stack)-2])
+
+	return nil
+}
+
+func opSwap(te *tealEval) error {
+	last := len(te.stack) - 1
+	te.stack[last], te.stack[last-1] = te.stack[last-1], te.stack[last]
+
+	return nil
+}
+
+// opSelect pops A, B and C and pushes B when C is not zero, A otherwise
+func opSelect(te *tealEval) error {
+	c, err := te.popUint()
+	if err != nil {
+		return err
+	}
+
+	b := te.pop()
+	a := te.pop()
+	if c != 0 {
+		te.push(b)
+	} else {
+		te.push(a)
+	}
+
+	return nil
+}
+
+func opConcat(te *tealEval) error {
+	b, err := te.popBytes()
+	if err != nil {
+		return err
+	}
+	a, err := te.popBytes()
+	if err != nil {
+		return err
+	}
+	if len(a)+len(b) > maxStringSize {
+		return fmt.Errorf("concat resulted in string too long")
+	}
+
+	te.push(bytesValue(append(append([]byte(nil), a...), b...)))
+
+	return nil
+}
+
+func (te *tealEval) pushSubstring(b []byte, start, end uint64) error {
+	if end < start {
+		return fmt.Errorf("substring end before start")
+	}
+	if end > uint64(len(b)) {
+		return fmt.Errorf("substring range beyond length of string")
+	}
+	te.push(bytesValue(b[start:end]))
+
+	return nil
+}
+
+func opSubstring(te *tealEval) error {
+	imm, err := te.immediate(2)
+	if err != nil {
+		return err
+	}
+	b, err := te.popBytes()
+	if err != nil {
+		return err
+	}
+
+	return te.pushSubstring(b, uint64(imm[0]), uint64(imm[1]))
+}
+
+func opSubstring3(te *tealEval) error {
+	v, err := te.popUints(2)
+	if err != nil {
+		return err
+	}
+	b, err := te.popBytes()
+	if err != nil {
+		return err
+	}
+
+	return te.pushSubstring(b, v[0], v[1])
+}
+
+// account resolves an account reference, either an index of the Accounts array where 0 is the sender or an available address
+func (te *tealEval) account(v stackValue) (algoTypes.Address, error) {
+	txn := te.txn()
+	if !v.isBytes {
+		if v.uint == 0 {
+			return txn.Sender, nil
+		}
+		if v.uint > uint64(len(txn.Accounts)) {
+			return algoTypes.Address{}, fmt.Errorf("invalid Account reference %d", v.uint)
+		}
+
+		return txn.Accounts[v.uint-1], nil
+	}
+
+	var addr algoTypes.Address
+	if len(v.bytes) != len(addr) {
+		return addr, fmt.Errorf("invalid Account reference %x", v.bytes)
+	}
+	copy(addr[:], v.bytes)
+	if addr == txn.Sender {
+		return addr, nil
+	}
+	for _, account := range txn.Accounts {
+		if account == addr {
+			return addr, nil
+		}
+	}
+
+	return addr, fmt.Errorf("invalid Account reference %s", addr.String())
+}
+
+// asset resolves an asset reference, either an index of the ForeignAssets array or an available asset id
+func (te *tealEval) asset(v uint64) (uint64, error) {
+	assets := te.txn().ForeignAssets
+	if v < uint64(len(assets)) {
+		return uint64(assets[v]), nil
+	}
+	for _, assetID := range assets {
+		if uint64(assetID) == v {
+			return v, nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid Asset reference %d", v)
+}
+
+func opBalance(te *tealEval) error {
+	addr, err := te.account(te.pop())
+	if err != nil {
+		return err
+	}
+	te.push(uintValue(te.e.ledger.Account(addr).Algo))
+
+	return nil
+}
+
+func opMinBalance(te *tealEval) error {
+	addr, err := te.account(te.pop())
+	if err != nil {
+		return err
+	}
+	te.push(uintValue(te.e.ledger.MinBalance(addr)))
+
+	return nil
+}
+
+// localState returns the local state of an account reference in the called app
+func (te *tealEval) localState(v stackValue) (map[string]uint64, error) {
+	addr, err := te.account(v)
+	if err != nil {
+		return nil, err
+	}
+
+	appID := uint64(te.txn().ApplicationID)
+	state, ok := te.e.ledger.Account(addr).Local[appID]
+	if !ok {
+		return nil, fmt.Errorf("address %s has not opted in to application %d", addr.String(), appID)
+	}
+
+	return state, nil
+}
+
+func opAppLocalGet(te *tealEval) error {
+	key, err := te.popBytes()
+	if err != nil {
+		return err
+	}
+	state, err := te.localState(te.pop())
+	if err != nil {
+		return err
+	}
+	te.push(uintValue(state[string(key)]))
+
+	return nil
+}
+
+// opAppLocalPut stores a value in a local state, the validator app schema has no byte slices so only uints can be stored
+func opAppLocalPut(te *tealEval) error {
+	value := te.pop()
+	key, err := te.popBytes()
+	if err != nil {
+		return err
+	}
+	state, err := te.localState(te.pop())
+	if err != nil {
+		return err
+	}
+	if len(key) > maxAppKeyLength {
+		return fmt.Errorf("key too long: length was %d, maximum is %d", len(key), maxAppKeyLength)
+	}
+	if value.isBytes {
+		return fmt.Errorf("store bytes count 1 exceeds schema bytes count 0")
+	}
+	state[string(key)] = value.uint
+
+	return nil
+}
+
+func opAppLocalDel(te *tealEval) error {
+	key, err := te.popBytes()
+	if err != nil {
+		return err
+	}
+	state, err := te.localState(te.pop())
+	if err != nil {
+		return err
+	}
+	delete(state, string(key))
+
+	return nil
+}
+
+func opAssetHoldingGet(te *tealEval) error {
+	imm, err := te.immediate(1)
+	if err != nil {
+		return err
+	}
+	v, err := te.popUint()
+	if err != nil {
+		return err
+	}
+	assetID, err := te.asset(v)
+	if err != nil {
+		return err
+	}
+	addr, err := te.account(te.pop())
+	if err != nil {
+		return err
+	}
+
+	amount, ok := te.e.ledger.Account(addr).Assets[assetID]
+	switch imm[0] {
+	case 0:
+		te.push(uintValue(amount))
+	case 1:
+		// frozen assets are not simulated
+		te.push(uintValue(0))
+	default:
+		return fmt.Errorf("invalid asset holding field %d", imm[0])
+	}
+	te.push(boolValue(ok))
+
+	return nil
+}
+
+func opAssetParamsGet(te *tealEval) error {
+	imm, err := te.immediate(1)
+	if err != nil {
+		return err
+	}
+	v, err := te.popUint()
+	if err != nil {
+		return err
+	}
+	assetID, err := te.asset(v)
+	if err != nil {
+		return err
+	}
+
+	params, ok := te.e.ledger.Assets[assetID]
+	if !ok {
+		te.push(uintValue(0))
+		te.push(uintValue(0))
+
+		return nil
+	}
+
+	address := func(addr string) stackValue {
+		decoded, _ := algoTypes.DecodeAddress(addr)
+
+		return bytesValue(append([]byte(nil), decoded[:]...))
+	}
+	switch imm[0] {
+	case 0:
+		te.push(uintValue(params.Total))
+	case 1:
+		te.push(uintValue(params.Decimals))
+	case 2:
+		te.push(boolValue(params.DefaultFrozen))
+	case 3:
+		te.push(bytesValue([]byte(params.UnitName)))
+	case 4:
+		te.push(bytesValue([]byte(params.Name)))
+	case 5:
+		te.push(bytesValue([]byte(params.Url)))
+	case 6:
+		te.push(bytesValue(params.MetadataHash))
+	case 7:
+		te.push(address(params.Manager))
+	case 8:
+		te.push(address(params.Reserve))
+	case 9:
+		te.push(address(params.Freeze))
+	case 10:
+		te.push(address(params.Clawback))
+	default:
+		return fmt.Errorf("invalid asset params field %d", imm[0])
+	}
+	te.push(uintValue(1))
+
+	return nil
+}
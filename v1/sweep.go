@@ -0,0 +1,189 @@
+package tinyman
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	algoTypes "github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/pools"
+)
+
+// SweepOptions configures redeeming excess amounts of a user across pools
+type SweepOptions struct {
+	// MinAmounts maps asset ids to the minimum excess amounts worth redeeming, smaller amounts are skipped
+	MinAmounts map[uint64]uint64
+
+	// Signer signs redeem transaction groups, it is only required by SweepExcess and the sweep after Submit
+	Signer utils.Signer
+
+	// RedeemAll redeems every non-zero excess amount, including amounts which are not worth the redeem fee
+	RedeemAll bool
+}
+
+// ExcessSweep is a plan which redeems excess amounts of a user
+type ExcessSweep struct {
+	// Plan holds one redeem transaction group per redeemed amount
+	Plan *utils.TransactionPlan
+
+	// Redeemed are excess amounts redeemed by the plan, in the order of its groups
+	Redeemed []types.RedeemQuote
+
+	// Skipped are excess amounts below their minimum amounts or not worth the redeem fee
+	Skipped []types.RedeemQuote
+
+	// TxIDs are pending transaction ids of the redeem groups after they are submitted
+	TxIDs []string
+}
+
+// PrepareExcessSweep finds excess amounts of a user across pools and prepares a plan which redeems them.
+// Amounts below SweepOptions.MinAmounts are skipped, so are ALGO amounts, or pool asset amounts worth in ALGO, which do not cover the redeem fee
+// unless SweepOptions.RedeemAll is set.
+func (c *Client) PrepareExcessSweep(ctx context.Context, userAddress string, opts *SweepOptions) (*ExcessSweep, error) {
+	if len(userAddress) == 0 {
+		userAddress = c.UserAddress
+	}
+	if opts == nil {
+		opts = &SweepOptions{}
+	}
+
+	user, err := algoTypes.DecodeAddress(userAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	quotes, err := c.FetchExcessAmount(ctx, userAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(quotes, func(i, j int) bool {
+		if quotes[i].PoolAddress != quotes[j].PoolAddress {
+			return quotes[i].PoolAddress < quotes[j].PoolAddress
+		}
+
+		return quotes[i].Amount.Asset.ID < quotes[j].Amount.Asset.ID
+	})
+
+	sweep := &ExcessSweep{}
+	var txGroups []*utils.TransactionGroup
+	poolsByAddress := make(map[string]*pools.Pool)
+	for idx := range quotes {
+		quote := quotes[idx]
+		if quote.Amount.Amount == 0 || quote.Amount.Amount < opts.MinAmounts[quote.Amount.Asset.ID] {
+			sweep.Skipped = append(sweep.Skipped, quote)
+			continue
+		}
+
+		pool, ok := poolsByAddress[quote.PoolAddress]
+		if !ok {
+			account, err := c.ac.AccountInformation(ctx, quote.PoolAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			pool, err = pools.FromAccountInfo(ctx, account, c.cachedAlgod(), userAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			poolsByAddress[quote.PoolAddress] = pool
+		}
+
+		txGroup, err := pool.PrepareRedeemTransactionsFromQuote(ctx, &quote, userAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		worth, ok := algoWorth(pool, &quote.Amount)
+		if !opts.RedeemAll && ok && worth <= redeemCost(txGroup, user) {
+			sweep.Skipped = append(sweep.Skipped, quote)
+			continue
+		}
+
+		txGroups = append(txGroups, txGroup)
+		sweep.Redeemed = append(sweep.Redeemed, quote)
+	}
+
+	sweep.Plan = utils.NewTransactionPlan(txGroups...)
+
+	return sweep, nil
+}
+
+// SweepExcess redeems excess amounts of a user across pools with the signer of the options
+func (c *Client) SweepExcess(ctx context.Context, userAddress string, opts *SweepOptions) (*ExcessSweep, error) {
+	if opts == nil || opts.Signer == nil {
+		return nil, fmt.Errorf("a signer is required to sweep excess amounts")
+	}
+
+	sweep, err := c.PrepareExcessSweep(ctx, userAddress, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sweep.Plan.SignWith(ctx, opts.Signer); err != nil {
+		return nil, err
+	}
+
+	sweep.TxIDs, err = sweep.Plan.Submit(ctx, c.ac, true)
+	if err != nil {
+		return sweep, err
+	}
+
+	return sweep, nil
+}
+
+// sweepAfterSubmit sweeps excess amounts of the user of a submitted group when it calls the validator app for anything but a redeem
+func (c *Client) sweepAfterSubmit(ctx context.Context, txGroup *utils.TransactionGroup) error {
+	for _, tx := range txGroup.Transactions() {
+		if tx.Type != algoTypes.ApplicationCallTx || uint64(tx.ApplicationID) != c.ValidatorAppID {
+			continue
+		}
+		if len(tx.ApplicationArgs) == 0 || string(tx.ApplicationArgs[0]) == "redeem" || len(tx.Accounts) == 0 {
+			return nil
+		}
+
+		_, err := c.SweepExcess(ctx, tx.Accounts[0].String(), c.SweepAfterSubmit)
+
+		return err
+	}
+
+	return nil
+}
+
+// algoWorth returns an ALGO amount which an asset amount of a pool is worth, it is false when the amount cannot be priced in ALGO
+func algoWorth(pool *pools.Pool, amount *types.AssetAmount) (uint64, bool) {
+	if amount.Asset.ID == 0 {
+		return amount.Amount, true
+	}
+	if pool.Asset2.ID != 0 || pool.Asset1Reserves == 0 || pool.Asset2Reserves == 0 || !amount.Asset.Equal(pool.Asset1) {
+		return 0, false
+	}
+
+	worth, err := pool.Convert(amount)
+	if err != nil {
+		return 0, false
+	}
+
+	return worth.Amount, true
+}
+
+// redeemCost returns fees and fee payments which a user pays for a redeem transaction group
+func redeemCost(txGroup *utils.TransactionGroup, user algoTypes.Address) uint64 {
+	var cost uint64
+	for _, tx := range txGroup.Transactions() {
+		if tx.Sender != user {
+			continue
+		}
+
+		cost += uint64(tx.Fee)
+		if tx.Type == algoTypes.PaymentTx {
+			cost += uint64(tx.Amount)
+		}
+	}
+
+	return cost
+}
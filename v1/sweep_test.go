@@ -0,0 +1,79 @@
+package tinyman_test
+
+import (
+	"testing"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1"
+	"github.com/synycboom/tinyman-go-sdk/v1/tinymantest"
+)
+
+func TestSweepExcess(t *testing.T) {
+	e := tinymantest.New(t)
+	e.OptIn()
+	algo, err := e.Client.FetchAsset(e.Ctx, 0)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	token := e.CreateAsset("TKN")
+	pool := e.CreatePool(token, algo, 100000000000, 10000000000)
+
+	// a tiny ALGO excess does not cover the redeem fee
+	quote, err := pool.FetchFixedInputSwapQuote(e.Ctx, &types.AssetAmount{Asset: token, Amount: 100000}, 500)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	e.Submit(pool.PrepareSwapTransactionsFromQuote(e.Ctx, quote, ""))
+
+	quote, err = pool.FetchFixedInputSwapQuote(e.Ctx, &types.AssetAmount{Asset: algo, Amount: 1000000000}, 500)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	e.Submit(pool.PrepareSwapTransactionsFromQuote(e.Ctx, quote, ""))
+
+	sweep, err := e.Client.PrepareExcessSweep(e.Ctx, "", &tinyman.SweepOptions{MinAmounts: map[uint64]uint64{token.ID: quote.AmountOut.Amount}})
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(sweep.Redeemed) != 0 || len(sweep.Skipped) != 2 {
+		t.Fatalf("PrepareExcessSweep should skip amounts below the minimum and the fee, got %d redeemed", len(sweep.Redeemed))
+	}
+
+	before := e.Sim.Balance(e.User.Address.String(), token.ID)
+	sweep, err = e.Client.SweepExcess(e.Ctx, "", &tinyman.SweepOptions{Signer: utils.NewAccountSigner(e.User)})
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(sweep.Redeemed) != 1 || sweep.Redeemed[0].Amount.Asset.ID != token.ID || len(sweep.TxIDs) != 1 {
+		t.Fatalf("SweepExcess should redeem the token excess, got %d redeemed", len(sweep.Redeemed))
+	}
+	if received := e.Sim.Balance(e.User.Address.String(), token.ID) - before; received != sweep.Redeemed[0].Amount.Amount {
+		t.Errorf("User received %d instead of %d", received, sweep.Redeemed[0].Amount.Amount)
+	}
+}
+
+func TestSweepAfterSubmit(t *testing.T) {
+	e := tinymantest.New(t)
+	e.OptIn()
+	algo, err := e.Client.FetchAsset(e.Ctx, 0)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	token := e.CreateAsset("TKN")
+	pool := e.CreatePool(token, algo, 100000000000, 10000000000)
+	e.Client.SweepAfterSubmit = &tinyman.SweepOptions{Signer: utils.NewAccountSigner(e.User)}
+
+	quote, err := pool.FetchFixedInputSwapQuote(e.Ctx, &types.AssetAmount{Asset: algo, Amount: 1000000000}, 500)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	before := e.Sim.Balance(e.User.Address.String(), token.ID)
+	e.Submit(pool.PrepareSwapTransactionsFromQuote(e.Ctx, quote, ""))
+
+	// the excess left by the slippage is redeemed right after the swap
+	if received := e.Sim.Balance(e.User.Address.String(), token.ID) - before; received != quote.AmountOut.Amount {
+		t.Errorf("User received %d instead of %d", received, quote.AmountOut.Amount)
+	}
+}
@@ -0,0 +1,161 @@
+// Package tinymantest provides a shared test fixture: a simulator running the testnet validator app,
+// a funded user with a client and helpers which create assets and pools.
+package tinymantest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1"
+	"github.com/synycboom/tinyman-go-sdk/v1/constants"
+	"github.com/synycboom/tinyman-go-sdk/v1/pools"
+	"github.com/synycboom/tinyman-go-sdk/v1/simulator"
+)
+
+// UserBalance is the Algo balance a fixture user starts with
+const UserBalance = 1000000000000
+
+// Fixture is a simulator with a funded user and a client of the user, helpers fail the test on any error
+type Fixture struct {
+	T      testing.TB
+	Ctx    context.Context
+	Sim    *simulator.Simulator
+	Client *tinyman.Client
+	User   crypto.Account
+}
+
+// New creates a fixture whose user holds UserBalance micro Algos and is not opted in the validator app yet
+func New(t testing.TB) *Fixture {
+	user := crypto.GenerateAccount()
+	sim := simulator.New(constants.TestnetValidatorAppId)
+	if err := sim.SetBalance(user.Address.String(), 0, UserBalance); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	return &Fixture{
+		T:      t,
+		Ctx:    context.Background(),
+		Sim:    sim,
+		Client: tinyman.NewClient(sim, constants.TestnetValidatorAppId, user.Address.String()),
+		User:   user,
+	}
+}
+
+// NewUser creates a fixture of another user on the same simulator, the user holds UserBalance micro Algos and is not opted in
+func (f *Fixture) NewUser() *Fixture {
+	user := crypto.GenerateAccount()
+	if err := f.Sim.SetBalance(user.Address.String(), 0, UserBalance); err != nil {
+		f.T.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	return &Fixture{
+		T:      f.T,
+		Ctx:    f.Ctx,
+		Sim:    f.Sim,
+		Client: tinyman.NewClient(f.Sim, f.Sim.ValidatorAppID(), user.Address.String()),
+		User:   user,
+	}
+}
+
+// Address returns the address of the user
+func (f *Fixture) Address() string {
+	return f.User.Address.String()
+}
+
+// OptIn opts the user in the validator app
+func (f *Fixture) OptIn() {
+	f.Submit(f.Client.PrepareAppOptInTransaction(f.Ctx, ""))
+}
+
+// Submit signs a prepared transaction group by the user and submits it, a preparation error fails the test as well
+func (f *Fixture) Submit(txGroup *utils.TransactionGroup, err error) {
+	if err != nil {
+		f.T.Fatalf("Unexpected err %s", err.Error())
+	}
+	if err := f.TrySubmit(txGroup); err != nil {
+		f.T.Fatalf("Unexpected err %s", err.Error())
+	}
+}
+
+// TrySubmit signs a transaction group by the user and submits it, it returns a rejection instead of failing the test
+func (f *Fixture) TrySubmit(txGroup *utils.TransactionGroup) error {
+	if err := txGroup.Sign(&f.User); err != nil {
+		return err
+	}
+
+	_, err := f.Client.Submit(f.Ctx, txGroup, true)
+
+	return err
+}
+
+// Asset fetches an asset, asset id 0 is Algo
+func (f *Fixture) Asset(assetID uint64) *types.Asset {
+	asset, err := f.Client.FetchAsset(f.Ctx, assetID)
+	if err != nil {
+		f.T.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	return asset
+}
+
+// CreateAsset creates an asset with 6 decimals whose supply is held by the user, the name is used as the unit name as well
+func (f *Fixture) CreateAsset(name string) *types.Asset {
+	assetID, err := f.Sim.CreateAsset(f.Address(), 1000000000000000, 6, name, name)
+	if err != nil {
+		f.T.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	return f.Asset(assetID)
+}
+
+// CreatePool bootstraps a pool and mints its first liquidity from the user, the user has to be opted in the validator app
+func (f *Fixture) CreatePool(assetA, assetB *types.Asset, amountA, amountB uint64) *pools.Pool {
+	pool, err := f.Client.FetchPool(f.Ctx, assetA, assetB, false)
+	if err != nil {
+		f.T.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	f.Submit(pool.PrepareBootstrapTransactions(f.Ctx, ""))
+	f.Refresh(pool)
+	f.Submit(pool.PrepareLiquidityAssetOptInTransactions(f.Ctx, ""))
+
+	quote, err := pool.FetchMintQuote(
+		f.Ctx,
+		&types.AssetAmount{Asset: assetA, Amount: amountA},
+		&types.AssetAmount{Asset: assetB, Amount: amountB},
+		0,
+	)
+	if err != nil {
+		f.T.Fatalf("Unexpected err %s", err.Error())
+	}
+	f.Submit(pool.PrepareMintTransactionsFromQuote(f.Ctx, quote, ""))
+	f.Refresh(pool)
+
+	return pool
+}
+
+// SeedPool writes a pool state to the ledger without executing any transaction and returns the pool fetched by the client.
+// Assets of the pool have to exist, the ALGO balance of the pool should include its minimum balance.
+func (f *Fixture) SeedPool(info types.PoolInfo) *pools.Pool {
+	if _, err := f.Sim.SetPool(info); err != nil {
+		f.T.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	pool, err := f.Client.FetchPool(f.Ctx, f.Asset(info.Asset1ID), f.Asset(info.Asset2ID), true)
+	if err != nil {
+		f.T.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	return pool
+}
+
+// Refresh refreshes a pool from the simulator
+func (f *Fixture) Refresh(pool *pools.Pool) {
+	if err := pool.Refresh(f.Ctx, nil); err != nil {
+		f.T.Fatalf("Unexpected err %s", err.Error())
+	}
+}
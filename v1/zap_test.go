@@ -0,0 +1,186 @@
+package tinyman_test
+
+import (
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+	algoTypes "github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/synycboom/tinyman-go-sdk/types"
+	"github.com/synycboom/tinyman-go-sdk/utils"
+	"github.com/synycboom/tinyman-go-sdk/v1/tinymantest"
+)
+
+func TestZapIn(t *testing.T) {
+	e := tinymantest.New(t)
+	e.OptIn()
+	algo, err := e.Client.FetchAsset(e.Ctx, 0)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	token := e.CreateAsset("TKN")
+	pool := e.CreatePool(token, algo, 100000000000, 10000000000)
+
+	// a fresh account holding only ALGO zaps into the pool
+	lp := crypto.GenerateAccount()
+	if err := e.Sim.SetBalance(lp.Address.String(), 0, 10000000000); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	quote, err := pool.FetchZapInQuote(e.Ctx, &types.AssetAmount{Asset: algo, Amount: 1000000000}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if quote.MinLiquidityAssetAmount.Amount >= quote.LiquidityAssetAmount.Amount || quote.SwapQuote.AmountIn.Amount >= 1000000000 {
+		t.Fatalf("ZapInQuote returned %d liquidity with a bound of %d", quote.LiquidityAssetAmount.Amount, quote.MinLiquidityAssetAmount.Amount)
+	}
+
+	plan, err := pool.PrepareZapInPlanFromQuote(e.Ctx, quote, lp.Address.String())
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if err := plan.SignWith(e.Ctx, utils.NewAccountSigner(lp)); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if _, err := plan.Submit(e.Ctx, e.Sim, true); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	if received := e.Sim.Balance(lp.Address.String(), pool.LiquidityAsset.ID); received != quote.LiquidityAssetAmount.Amount {
+		t.Errorf("User received %d liquidity instead of %d", received, quote.LiquidityAssetAmount.Amount)
+	}
+
+	excess, err := pool.FetchExcessAmounts(e.Ctx, lp.Address.String())
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(excess) != 0 {
+		t.Errorf("The plan should redeem every excess amount, %d are left", len(excess))
+	}
+}
+
+func TestZapOut(t *testing.T) {
+	e := tinymantest.New(t)
+	e.OptIn()
+	algo, err := e.Client.FetchAsset(e.Ctx, 0)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	token := e.CreateAsset("TKN")
+	pool := e.CreatePool(token, algo, 100000000000, 10000000000)
+
+	liquidity := &types.AssetAmount{Asset: pool.LiquidityAsset, Amount: 1000000000}
+	quote, err := pool.FetchZapOutQuote(e.Ctx, liquidity, algo, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if quote.MinAmountOut.Amount >= quote.AmountOut.Amount {
+		t.Fatalf("ZapOutQuote returned %d with a bound of %d", quote.AmountOut.Amount, quote.MinAmountOut.Amount)
+	}
+
+	plan, err := pool.PrepareZapOutPlanFromQuote(e.Ctx, quote, "")
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	user := e.User.Address.String()
+	sent := len(e.Sim.SentTransactions())
+	algoBefore := e.Sim.Balance(user, 0)
+	tokenBefore := e.Sim.Balance(user, token.ID)
+	if err := plan.SignWith(e.Ctx, utils.NewAccountSigner(e.User)); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if _, err := plan.Submit(e.Ctx, e.Sim, true); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	// the redeem groups are prepared on submission, so the cost is counted from the sent transactions
+	var cost uint64
+	for _, stxns := range e.Sim.SentTransactions()[sent:] {
+		for _, stxn := range stxns {
+			tx := stxn.Txn
+			if tx.Sender == e.User.Address {
+				cost += uint64(tx.Fee)
+				if tx.Type == algoTypes.PaymentTx {
+					cost += uint64(tx.Amount)
+				}
+			}
+		}
+	}
+	// the pool keeps the fee payment of the burn in its ALGO balance, so the swap pays slightly more than quoted
+	if received := e.Sim.Balance(user, 0) + cost - algoBefore; received < quote.AmountOut.Amount {
+		t.Errorf("User received %d ALGO instead of at least %d", received, quote.AmountOut.Amount)
+	}
+	if tokenAfter := e.Sim.Balance(user, token.ID); tokenAfter != tokenBefore {
+		t.Errorf("User should not keep any of the other asset, token balance went from %d to %d", tokenBefore, tokenAfter)
+	}
+
+	excess, err := pool.FetchExcessAmounts(e.Ctx, "")
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if len(excess) != 0 {
+		t.Errorf("The plan should redeem every excess amount, %d are left", len(excess))
+	}
+}
+
+func TestZapOutBurnBelowQuote(t *testing.T) {
+	e := tinymantest.New(t)
+	e.OptIn()
+	algo, err := e.Client.FetchAsset(e.Ctx, 0)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	token := e.CreateAsset("TKN")
+	pool := e.CreatePool(token, algo, 100000000000, 10000000000)
+
+	liquidity := &types.AssetAmount{Asset: pool.LiquidityAsset, Amount: 1000000000}
+	quote, err := pool.FetchZapOutQuote(e.Ctx, liquidity, algo, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	plan, err := pool.PrepareZapOutPlanFromQuote(e.Ctx, quote, "")
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	// another account buys the token after the quote, so the burn pays out less of it than quoted
+	other := e.NewUser()
+	other.OptIn()
+	if err := e.Sim.SetBalance(other.Address(), token.ID, 0); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	otherPool, err := other.Client.FetchPool(other.Ctx, token, algo, true)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	swapQuote, err := otherPool.FetchFixedInputSwapQuote(other.Ctx, &types.AssetAmount{Asset: algo, Amount: 25000000}, 100)
+	if err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	other.Submit(otherPool.PrepareSwapTransactionsFromQuote(other.Ctx, swapQuote, ""))
+
+	user := e.User.Address.String()
+	tokenBefore := e.Sim.Balance(user, token.ID)
+	if err := plan.SignWith(e.Ctx, utils.NewAccountSigner(e.User)); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+	if _, err := plan.Submit(e.Ctx, e.Sim, true); err != nil {
+		t.Fatalf("Unexpected err %s", err.Error())
+	}
+
+	var sold uint64
+	for _, stxns := range e.Sim.SentTransactions() {
+		for _, stxn := range stxns {
+			if tx := stxn.Txn; tx.Sender == e.User.Address && tx.Type == algoTypes.AssetTransferTx && uint64(tx.XferAsset) == token.ID {
+				sold = tx.AssetAmount
+			}
+		}
+	}
+	if sold >= quote.SwapQuote.AmountIn.Amount {
+		t.Errorf("The swap should sell what the burn paid out, sold %d with %d quoted", sold, quote.SwapQuote.AmountIn.Amount)
+	}
+	if tokenAfter := e.Sim.Balance(user, token.ID); tokenAfter != tokenBefore {
+		t.Errorf("User should not keep or sell any other token, token balance went from %d to %d", tokenBefore, tokenAfter)
+	}
+}